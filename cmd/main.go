@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/opentracing/opentracing-go"
 
@@ -31,6 +32,13 @@ import (
 // @BasePath /api/v1
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == replayCommand {
+		if err := runReplay(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	log.Println("Starting products microservice")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
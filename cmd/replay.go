@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/products-microservice/config"
+	deadLetterRepository "github.com/AleksK1NG/products-microservice/internal/deadletter/repository"
+	deadLetterUseCase "github.com/AleksK1NG/products-microservice/internal/deadletter/usecase"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	prodKafka "github.com/AleksK1NG/products-microservice/internal/product/delivery/kafka"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+)
+
+const replayCommand = "replay"
+
+// runReplay Replay dead letter messages matching flags, progress is logged and final report is printed as JSON
+func runReplay(args []string) error {
+	fs := flag.NewFlagSet(replayCommand, flag.ExitOnError)
+	topics := fs.String("topics", "", "comma separated original topics, e.g. create-product,update-product")
+	from := fs.String("from", "", "failed at lower bound, RFC3339")
+	to := fs.String("to", "", "failed at upper bound, RFC3339")
+	errorContains := fs.String("error", "", "case insensitive text of error")
+	limit := fs.Int("limit", 0, "max number of matched messages, 0 means no limit")
+	dryRun := fs.Bool("dry-run", false, "only report matching messages")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	filter := &models.ReplayFilter{ErrorContains: *errorContains, Limit: *limit, DryRun: *dryRun}
+	if *topics != "" {
+		filter.Topics = strings.Split(*topics, ",")
+	}
+	var err error
+	if filter.From, err = parseReplayTime(*from); err != nil {
+		return errors.Wrap(err, "from")
+	}
+	if filter.To, err = parseReplayTime(*to); err != nil {
+		return errors.Wrap(err, "to")
+	}
+
+	cfg, err := config.ParseConfig()
+	if err != nil {
+		return err
+	}
+
+	appLogger := logger.NewApiLogger(cfg)
+	appLogger.InitLogger()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		<-quit
+		cancel()
+	}()
+
+	productsProducer := prodKafka.NewProductsProducer(appLogger, cfg)
+	productsProducer.Run()
+	defer productsProducer.Close()
+
+	deadLetterKafkaRepo := deadLetterRepository.NewDeadLetterKafkaRepository(appLogger, cfg)
+	// command replays synchronously and prints report, so no operation is tracked
+	deadLetterUC := deadLetterUseCase.NewDeadLetterUC(ctx, deadLetterKafkaRepo, productsProducer, nil, appLogger)
+
+	report, err := deadLetterUC.Replay(ctx, filter, func(report *models.ReplayReport) {
+		appLogger.Infof("dead letters replay progress: %s", report)
+	})
+	if err != nil {
+		return errors.Wrap(err, "deadLetterUC.Replay")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func parseReplayTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
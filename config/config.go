@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	GRPC_PORT = "GRPC_PORT"
-	HTTP_PORT = "HTTP_PORT"
+	GRPC_PORT   = "GRPC_PORT"
+	HTTP_PORT   = "HTTP_PORT"
+	ADMIN_TOKEN = "ADMIN_TOKEN"
 )
 
 // Config of application
@@ -24,6 +25,7 @@ type Config struct {
 	Kafka      Kafka
 	Http       Http
 	Redis      Redis
+	Admin      Admin
 }
 
 // Server config
@@ -46,6 +48,10 @@ type Http struct {
 	WriteTimeout      time.Duration
 	CookieLifeTime    int
 	SessionCookieName string
+	// WriteMode default products write mode, async publishes to Kafka, sync writes to MongoDB directly
+	WriteMode string
+	// ProductCacheMaxAge seconds clients and CDN may use product without revalidation
+	ProductCacheMaxAge int
 }
 
 // Logger config
@@ -79,6 +85,14 @@ type MongoDB struct {
 
 type Kafka struct {
 	Brokers []string
+	// MaxAttempts processing attempts of product message before it is sent to dead letter queue
+	MaxAttempts int
+}
+
+// Admin config
+type Admin struct {
+	// Token bearer token of admin endpoints, admin endpoints are disabled when it is empty
+	Token string
 }
 
 type Redis struct {
@@ -131,5 +145,10 @@ func ParseConfig() (*Config, error) {
 		c.Http.Port = httpPort
 	}
 
+	adminToken := os.Getenv(ADMIN_TOKEN)
+	if adminToken != "" {
+		c.Admin.Token = adminToken
+	}
+
 	return &c, nil
 }
@@ -24,9 +24,346 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/dead-letters/replay": {
+            "post": {
+                "description": "Republish original payloads of dead-letter-queue messages matching filter to create-product, update-product or delete-product,\ndry run only reports matching messages. Replay runs in background, returned operation has replay report as result",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Replay dead letter messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "admin bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "replay filter",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReplayFilter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.Operation"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Get direct children of category, root categories if parentId is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get children categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parent category id",
+                        "name": "parentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "number of elements",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CategoriesList"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new category, root category if parentId is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Create new category",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Category"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{category_id}": {
+            "get": {
+                "description": "Get single category by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get category by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category id",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Category"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update single category by id, changing parentId moves the whole subtree",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Update single category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category id",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Category"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete single category by id, category must have no children and no products",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Delete single category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category id",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/categories/{category_id}/products": {
+            "get": {
+                "description": "List products of category, optionally including all descendant categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "List products of category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "category id",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include products of descendant categories",
+                        "name": "includeDescendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "number of elements",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of previous response, page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "skip total count calculation",
+                        "name": "skipCount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductsList"
+                        }
+                    }
+                }
+            }
+        },
+        "/operations/{operation_id}": {
+            "get": {
+                "description": "Get status of asynchronous product create, update or delete",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operations"
+                ],
+                "summary": "Get operation by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "operation id",
+                        "name": "operation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Operation"
+                        }
+                    }
+                }
+            }
+        },
         "/products": {
             "post": {
-                "description": "Create new single product",
+                "description": "Create new single product, asynchronously by default, sync write mode returns stored product.\nRepeated request with the same Idempotency-Key returns result of the first one, the key reused with different product is rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create new product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "client generated unique request key, kept for 24 hours",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "write mode: async or sync",
+                        "name": "X-Write-Mode",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "write mode: async or sync, overrides header",
+                        "name": "writeMode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "operation in async mode, models.Product in sync mode",
+                        "schema": {
+                            "$ref": "#/definitions/models.Operation"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "url of product"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/batch-get": {
+            "post": {
+                "description": "Get up to 1000 products in request order, unknown or archived ids are returned in notFound",
                 "consumes": [
                     "application/json"
                 ],
@@ -36,10 +373,445 @@ var doc = `{
                 "tags": [
                     "Products"
                 ],
-                "summary": "Create new product",
+                "summary": "Get many products by ids",
+                "parameters": [
+                    {
+                        "description": "product ids",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductsBatch"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/bulk": {
+            "put": {
+                "description": "Update up to 1000 products by productId synchronously, products with version are updated only if it matches,\nevery product is reported in result instead of failing the whole request, products without productId\nor with repeated productId are failed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update many products",
+                "parameters": [
+                    {
+                        "description": "products",
+                        "name": "products",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Product"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create up to 1000 products synchronously, every product is reported in result instead of failing the whole request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create many products",
+                "parameters": [
+                    {
+                        "description": "products",
+                        "name": "products",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Product"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/search": {
+            "get": {
+                "description": "Full text search product by name or description, results are ranked by relevance unless orderBy is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Search product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search text",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "number of elements",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "text search language, e.g. english, russian or none",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include archived products",
+                        "name": "includeArchived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "minimum price",
+                        "name": "minPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "maximum price",
+                        "name": "maxPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "minimum rating",
+                        "name": "minRating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only products with positive quantity",
+                        "name": "inStock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated category ids",
+                        "name": "categoryIds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created at lower bound, RFC3339",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created at upper bound, RFC3339",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "updated at lower bound, RFC3339",
+                        "name": "updatedFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "updated at upper bound, RFC3339",
+                        "name": "updatedTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort fields with optional direction: price, rating, createdAt, updatedAt, name, relevance, e.g. price:desc,name",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of previous response, page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "skip total count calculation",
+                        "name": "skipCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated facet counts to return: category, price, rating",
+                        "name": "facets",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProductsList"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/suggest": {
+            "get": {
+                "description": "Get names of products starting with query for typeahead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Suggest product names",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "name prefix",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max number of suggestions, 10 by default, at most 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ProductSuggestion"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}": {
+            "get": {
+                "description": "Get single product by id, If-None-Match or If-Modified-Since matching current product return 304 without body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get product by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "product id",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of cached product",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of cached product",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Product"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "revalidation policy"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "product version"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "product update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "product not modified",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update single product by id, mismatching If-Match or body version returns 409 in both write modes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update single product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "product id",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected product ETag, overrides body version",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "write mode: async or sync",
+                        "name": "X-Write-Mode",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "write mode: async or sync, overrides header",
+                        "name": "writeMode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "operation in async mode, models.Product in sync mode",
+                        "schema": {
+                            "$ref": "#/definitions/models.Operation"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft delete single product by id, product can be restored later",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete single product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "product id",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Operation"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update only fields present in JSON merge patch body, null resets field, patch is applied synchronously, mismatching If-Match returns 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Partially update single product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "product id",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected product ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/models.Product"
                         }
@@ -47,9 +819,9 @@ var doc = `{
                 }
             }
         },
-        "/products/search": {
-            "get": {
-                "description": "Search product by name or description",
+        "/products/{product_id}/archive": {
+            "post": {
+                "description": "Archive (soft delete) single product by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -59,40 +831,29 @@ var doc = `{
                 "tags": [
                     "Products"
                 ],
-                "summary": "Search product",
+                "summary": "Archive single product",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "search text",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "number of elements",
-                        "name": "size",
-                        "in": "query"
+                        "description": "product id",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ProductsList"
+                            "$ref": "#/definitions/models.Product"
                         }
                     }
                 }
             }
         },
-        "/products/{product_id}": {
-            "get": {
-                "description": "Get single product by id",
+        "/products/{product_id}/restore": {
+            "post": {
+                "description": "Restore single archived product by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -102,7 +863,7 @@ var doc = `{
                 "tags": [
                     "Products"
                 ],
-                "summary": "Get product by id",
+                "summary": "Restore single product",
                 "parameters": [
                     {
                         "type": "string",
@@ -120,9 +881,11 @@ var doc = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/products/{product_id}/upsert": {
             "put": {
-                "description": "Update single product by id",
+                "description": "Create product with given id or replace all its fields, upsert is applied synchronously, archived product is not found",
                 "consumes": [
                     "application/json"
                 ],
@@ -132,7 +895,7 @@ var doc = `{
                 "tags": [
                     "Products"
                 ],
-                "summary": "Update single product",
+                "summary": "Create or replace single product",
                 "parameters": [
                     {
                         "type": "string",
@@ -148,12 +911,164 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/models.Product"
                         }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Product"
+                        }
                     }
                 }
             }
         }
     },
     "definitions": {
+        "models.BatchGetRequest": {
+            "type": "object",
+            "required": [
+                "productIds"
+            ],
+            "properties": {
+                "productIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.BulkItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "productId": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.BulkResult": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BulkItemResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CategoriesList": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Category"
+                    }
+                },
+                "hasMore": {
+                    "type": "boolean"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Category": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "categoryId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CategoryFacet": {
+            "type": "object",
+            "properties": {
+                "categoryId": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Operation": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "operationId": {
+                    "type": "string"
+                },
+                "productId": {
+                    "type": "string"
+                },
+                "result": {
+                    "type": "object"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Product": {
             "type": "object",
             "required": [
@@ -164,12 +1079,15 @@ var doc = `{
                 "rating"
             ],
             "properties": {
-                "CategoryId": {
+                "categoryId": {
                     "type": "string"
                 },
                 "createdAt": {
                     "type": "string"
                 },
+                "deletedAt": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -197,20 +1115,90 @@ var doc = `{
                 "rating": {
                     "type": "integer"
                 },
+                "score": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
                 "updatedAt": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Version incremented on every write, on update input it is the expected version and 0 skips the check",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ProductFacets": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryFacet"
+                    }
+                },
+                "price": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RangeFacet"
+                    }
+                },
+                "rating": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RangeFacet"
+                    }
+                }
+            }
+        },
+        "models.ProductSuggestion": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "productId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ProductsBatch": {
+            "type": "object",
+            "properties": {
+                "notFound": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Product"
+                    }
                 }
             }
         },
         "models.ProductsList": {
             "type": "object",
             "properties": {
+                "facets": {
+                    "$ref": "#/definitions/models.ProductFacets"
+                },
                 "hasMore": {
                     "type": "boolean"
                 },
+                "nextCursor": {
+                    "type": "string"
+                },
                 "page": {
                     "type": "integer"
                 },
+                "prevCursor": {
+                    "type": "string"
+                },
                 "products": {
                     "type": "array",
                     "items": {
@@ -227,6 +1215,46 @@ var doc = `{
                     "type": "integer"
                 }
             }
+        },
+        "models.RangeFacet": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ReplayFilter": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "errorContains": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "topics": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
         }
     }
 }`
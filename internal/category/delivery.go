@@ -0,0 +1,13 @@
+package category
+
+import "github.com/labstack/echo/v4"
+
+// HttpDelivery http delivery
+type HttpDelivery interface {
+	CreateCategory() echo.HandlerFunc
+	UpdateCategory() echo.HandlerFunc
+	GetByIDCategory() echo.HandlerFunc
+	GetChildrenCategory() echo.HandlerFunc
+	DeleteCategory() echo.HandlerFunc
+	ListProductsCategory() echo.HandlerFunc
+}
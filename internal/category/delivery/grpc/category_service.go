@@ -0,0 +1,193 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/opentracing/opentracing-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/category"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	grpcErrors "github.com/AleksK1NG/products-microservice/pkg/grpc_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+	categoriesService "github.com/AleksK1NG/products-microservice/proto/category"
+)
+
+// categoryService gRPC Service
+type categoryService struct {
+	log        logger.Logger
+	categoryUC category.UseCase
+	validate   *validator.Validate
+}
+
+// NewCategoryService categoryService constructor
+func NewCategoryService(log logger.Logger, categoryUC category.UseCase, validate *validator.Validate) *categoryService {
+	return &categoryService{log: log, categoryUC: categoryUC, validate: validate}
+}
+
+// Create create new category
+func (c *categoryService) Create(ctx context.Context, req *categoriesService.CreateReq) (*categoriesService.CreateRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryService.Create")
+	defer span.Finish()
+	createMessages.Inc()
+
+	parentID, err := parseParentID(req.GetParentID())
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	cat := &models.Category{
+		ParentID:    parentID,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+
+	if err := c.validate.StructCtx(ctx, cat); err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("validate.StructCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	created, err := c.categoryUC.Create(ctx, cat)
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("categoryUC.Create: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &categoriesService.CreateRes{Category: created.ToProto()}, nil
+}
+
+// Update Update existing category
+func (c *categoryService) Update(ctx context.Context, req *categoriesService.UpdateReq) (*categoriesService.UpdateRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryService.Update")
+	defer span.Finish()
+	updateMessages.Inc()
+
+	catID, err := primitive.ObjectIDFromHex(req.GetCategoryID())
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+	parentID, err := parseParentID(req.GetParentID())
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	cat := &models.Category{
+		CategoryID:  catID,
+		ParentID:    parentID,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+
+	if err := c.validate.StructCtx(ctx, cat); err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("validate.StructCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	updated, err := c.categoryUC.Update(ctx, cat)
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("categoryUC.Update: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &categoriesService.UpdateRes{Category: updated.ToProto()}, nil
+}
+
+// GetByID Get single category by id
+func (c *categoryService) GetByID(ctx context.Context, req *categoriesService.GetByIDReq) (*categoriesService.GetByIDRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryService.GetByID")
+	defer span.Finish()
+	getByIdMessages.Inc()
+
+	catID, err := primitive.ObjectIDFromHex(req.GetCategoryID())
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	cat, err := c.categoryUC.GetByID(ctx, catID)
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("categoryUC.GetByID: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &categoriesService.GetByIDRes{Category: cat.ToProto()}, nil
+}
+
+// GetChildren Get direct children of category, root categories for empty parent id
+func (c *categoryService) GetChildren(ctx context.Context, req *categoriesService.GetChildrenReq) (*categoriesService.GetChildrenRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryService.GetChildren")
+	defer span.Finish()
+	getChildrenMessages.Inc()
+
+	parentID, err := parseParentID(req.GetParentID())
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	categories, err := c.categoryUC.GetChildren(ctx, parentID, utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("categoryUC.GetChildren: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &categoriesService.GetChildrenRes{
+		TotalCount: categories.TotalCount,
+		TotalPages: categories.TotalPages,
+		Page:       categories.Page,
+		Size:       categories.Size,
+		HasMore:    categories.HasMore,
+		Categories: categories.ToProtoList(),
+	}, nil
+}
+
+// Delete Delete single category by id
+func (c *categoryService) Delete(ctx context.Context, req *categoriesService.DeleteReq) (*categoriesService.DeleteRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryService.Delete")
+	defer span.Finish()
+	deleteMessages.Inc()
+
+	catID, err := primitive.ObjectIDFromHex(req.GetCategoryID())
+	if err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.categoryUC.Delete(ctx, catID); err != nil {
+		errorMessages.Inc()
+		c.log.Errorf("categoryUC.Delete: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &categoriesService.DeleteRes{}, nil
+}
+
+// parseParentID empty parent id means root category
+func parseParentID(parentID string) (primitive.ObjectID, error) {
+	if parentID == "" {
+		return primitive.NilObjectID, nil
+	}
+	return primitive.ObjectIDFromHex(parentID)
+}
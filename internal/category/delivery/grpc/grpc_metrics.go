@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	successMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_success_incoming_grpc_messages_total",
+		Help: "The total number of success incoming success gRPC messages",
+	})
+	errorMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_error_incoming_grpc_message_total",
+		Help: "The total number of error incoming success gRPC messages",
+	})
+	createMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_create_incoming_grpc_requests_total",
+		Help: "The total number of incoming create category gRPC messages",
+	})
+	updateMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_update_incoming_grpc_requests_total",
+		Help: "The total number of incoming update category gRPC messages",
+	})
+	getByIdMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_get_by_id_incoming_grpc_requests_total",
+		Help: "The total number of incoming get by id category gRPC messages",
+	})
+	getChildrenMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_get_children_incoming_grpc_requests_total",
+		Help: "The total number of incoming get children categories gRPC messages",
+	})
+	deleteMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "categories_delete_incoming_grpc_requests_total",
+		Help: "The total number of incoming delete category gRPC messages",
+	})
+)
@@ -0,0 +1,313 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/category"
+	"github.com/AleksK1NG/products-microservice/internal/middlewares"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/internal/product"
+	httpErrors "github.com/AleksK1NG/products-microservice/pkg/http_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+type categoryHandlers struct {
+	log        logger.Logger
+	categoryUC category.UseCase
+	productUC  product.UseCase
+	validate   *validator.Validate
+	group      *echo.Group
+	mw         middlewares.MiddlewareManager
+}
+
+// NewCategoryHandlers constructor
+func NewCategoryHandlers(
+	log logger.Logger,
+	categoryUC category.UseCase,
+	productUC product.UseCase,
+	validate *validator.Validate,
+	group *echo.Group,
+	mw middlewares.MiddlewareManager,
+) *categoryHandlers {
+	return &categoryHandlers{log: log, categoryUC: categoryUC, productUC: productUC, validate: validate, group: group, mw: mw}
+}
+
+// CreateCategory Create category
+// @Tags Categories
+// @Summary Create new category
+// @Description Create new category, root category if parentId is empty
+// @Accept json
+// @Produce json
+// @Success 201 {object} models.Category
+// @Router /categories [post]
+func (h *categoryHandlers) CreateCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "categoryHandlers.Create")
+		defer span.Finish()
+		createRequests.Inc()
+
+		var cat models.Category
+		if err := c.Bind(&cat); err != nil {
+			h.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &cat); err != nil {
+			h.log.Errorf("validate.StructCtx: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		created, err := h.categoryUC.Create(ctx, &cat)
+		if err != nil {
+			h.log.Errorf("categoryUC.Create: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusCreated, created)
+	}
+}
+
+// UpdateCategory Update category
+// @Tags Categories
+// @Summary Update single category
+// @Description Update single category by id, changing parentId moves the whole subtree
+// @Accept json
+// @Produce json
+// @Param category_id path string true "category id"
+// @Success 200 {object} models.Category
+// @Router /categories/{category_id} [put]
+func (h *categoryHandlers) UpdateCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "categoryHandlers.Update")
+		defer span.Finish()
+		updateRequests.Inc()
+
+		var cat models.Category
+		if err := c.Bind(&cat); err != nil {
+			h.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		catID, err := primitive.ObjectIDFromHex(c.Param("category_id"))
+		if err != nil {
+			h.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+		cat.CategoryID = catID
+
+		if err := h.validate.StructCtx(ctx, &cat); err != nil {
+			h.log.Errorf("validate.StructCtx: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		updated, err := h.categoryUC.Update(ctx, &cat)
+		if err != nil {
+			h.log.Errorf("categoryUC.Update: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, updated)
+	}
+}
+
+// GetByIDCategory Get category by id
+// @Tags Categories
+// @Summary Get category by id
+// @Description Get single category by id
+// @Accept json
+// @Produce json
+// @Param category_id path string true "category id"
+// @Success 200 {object} models.Category
+// @Router /categories/{category_id} [get]
+func (h *categoryHandlers) GetByIDCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "categoryHandlers.GetByID")
+		defer span.Finish()
+		getByIdRequests.Inc()
+
+		catID, err := primitive.ObjectIDFromHex(c.Param("category_id"))
+		if err != nil {
+			h.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		cat, err := h.categoryUC.GetByID(ctx, catID)
+		if err != nil {
+			h.log.Errorf("categoryUC.GetByID: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, cat)
+	}
+}
+
+// GetChildrenCategory Get children categories
+// @Tags Categories
+// @Summary Get children categories
+// @Description Get direct children of category, root categories if parentId is empty
+// @Accept json
+// @Produce json
+// @Param parentId query string false "parent category id"
+// @Param page query string false "page number"
+// @Param size query string false "number of elements"
+// @Success 200 {object} models.CategoriesList
+// @Router /categories [get]
+func (h *categoryHandlers) GetChildrenCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "categoryHandlers.GetChildren")
+		defer span.Finish()
+		getChildrenRequests.Inc()
+
+		var parentID primitive.ObjectID
+		if c.QueryParam("parentId") != "" {
+			id, err := primitive.ObjectIDFromHex(c.QueryParam("parentId"))
+			if err != nil {
+				h.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+				errorRequests.Inc()
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+			parentID = id
+		}
+
+		pq := &utils.Pagination{}
+		if err := pq.SetSize(c.QueryParam("size")); err != nil {
+			h.log.Errorf("pq.SetSize: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+		if err := pq.SetPage(c.QueryParam("page")); err != nil {
+			h.log.Errorf("pq.SetPage: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+
+		result, err := h.categoryUC.GetChildren(ctx, parentID, pq)
+		if err != nil {
+			h.log.Errorf("categoryUC.GetChildren: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// DeleteCategory Delete category
+// @Tags Categories
+// @Summary Delete single category
+// @Description Delete single category by id, category must have no children and no products
+// @Accept json
+// @Produce json
+// @Param category_id path string true "category id"
+// @Success 200
+// @Router /categories/{category_id} [delete]
+func (h *categoryHandlers) DeleteCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "categoryHandlers.Delete")
+		defer span.Finish()
+		deleteRequests.Inc()
+
+		catID, err := primitive.ObjectIDFromHex(c.Param("category_id"))
+		if err != nil {
+			h.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.categoryUC.Delete(ctx, catID); err != nil {
+			h.log.Errorf("categoryUC.Delete: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// ListProductsCategory List products of category
+// @Tags Categories
+// @Summary List products of category
+// @Description List products of category, optionally including all descendant categories
+// @Accept json
+// @Produce json
+// @Param category_id path string true "category id"
+// @Param includeDescendants query bool false "include products of descendant categories"
+// @Param page query string false "page number"
+// @Param size query string false "number of elements"
+// @Param cursor query string false "nextCursor or prevCursor of previous response, page is ignored when set"
+// @Param skipCount query bool false "skip total count calculation"
+// @Success 200 {object} models.ProductsList
+// @Router /categories/{category_id}/products [get]
+func (h *categoryHandlers) ListProductsCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "categoryHandlers.ListProducts")
+		defer span.Finish()
+		listProductsRequests.Inc()
+
+		catID, err := primitive.ObjectIDFromHex(c.Param("category_id"))
+		if err != nil {
+			h.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var includeDescendants bool
+		if c.QueryParam("includeDescendants") != "" {
+			includeDescendants, err = strconv.ParseBool(c.QueryParam("includeDescendants"))
+			if err != nil {
+				h.log.Errorf("strconv.ParseBool: %v", err)
+				errorRequests.Inc()
+				return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+			}
+		}
+
+		pq := &utils.Pagination{}
+		if err := pq.SetSize(c.QueryParam("size")); err != nil {
+			h.log.Errorf("pq.SetSize: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+		if err := pq.SetPage(c.QueryParam("page")); err != nil {
+			h.log.Errorf("pq.SetPage: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+		if err := pq.SetSkipCount(c.QueryParam("skipCount")); err != nil {
+			h.log.Errorf("pq.SetSkipCount: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+		pq.SetCursor(c.QueryParam("cursor"))
+
+		result, err := h.productUC.ListByCategory(ctx, catID, includeDescendants, pq)
+		if err != nil {
+			h.log.Errorf("productUC.ListByCategory: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, result)
+	}
+}
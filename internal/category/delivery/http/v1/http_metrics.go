@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	successRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_success_incoming_messages_total",
+		Help: "The total number of success incoming success HTTP requests",
+	})
+	errorRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_error_incoming_message_total",
+		Help: "The total number of error incoming success HTTP requests",
+	})
+	createRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_create_incoming_requests_total",
+		Help: "The total number of incoming create category HTTP requests",
+	})
+	updateRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_update_incoming_requests_total",
+		Help: "The total number of incoming update category HTTP requests",
+	})
+	getByIdRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_get_by_id_incoming_requests_total",
+		Help: "The total number of incoming get by id category HTTP requests",
+	})
+	getChildrenRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_get_children_incoming_requests_total",
+		Help: "The total number of incoming get children categories HTTP requests",
+	})
+	listProductsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_list_products_incoming_requests_total",
+		Help: "The total number of incoming list category products HTTP requests",
+	})
+	deleteRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_categories_delete_incoming_requests_total",
+		Help: "The total number of incoming delete category HTTP requests",
+	})
+)
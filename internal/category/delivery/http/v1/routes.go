@@ -0,0 +1,11 @@
+package v1
+
+// MapRoutes categories routes
+func (h *categoryHandlers) MapRoutes() {
+	h.group.POST("", h.CreateCategory())
+	h.group.GET("", h.GetChildrenCategory())
+	h.group.PUT("/:category_id", h.UpdateCategory())
+	h.group.GET("/:category_id", h.GetByIDCategory())
+	h.group.DELETE("/:category_id", h.DeleteCategory())
+	h.group.GET("/:category_id/products", h.ListProductsCategory())
+}
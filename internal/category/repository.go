@@ -0,0 +1,29 @@
+package category
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+// MongoRepository Category
+type MongoRepository interface {
+	Create(ctx context.Context, category *models.Category) (*models.Category, error)
+	Update(ctx context.Context, category *models.Category) (*models.Category, error)
+	GetByID(ctx context.Context, categoryID primitive.ObjectID) (*models.Category, error)
+	GetChildren(ctx context.Context, parentID primitive.ObjectID, pagination *utils.Pagination) (*models.CategoriesList, error)
+	GetDescendantIDs(ctx context.Context, categoryID primitive.ObjectID) ([]primitive.ObjectID, error)
+	UpdateDescendantsPath(ctx context.Context, category *models.Category) error
+	CountProducts(ctx context.Context, categoryID primitive.ObjectID) (int64, error)
+	Delete(ctx context.Context, categoryID primitive.ObjectID) error
+}
+
+// RedisRepository Category
+type RedisRepository interface {
+	SetCategory(ctx context.Context, category *models.Category) error
+	GetCategoryByID(ctx context.Context, categoryID primitive.ObjectID) (*models.Category, error)
+	DeleteCategory(ctx context.Context, categoryID primitive.ObjectID) error
+}
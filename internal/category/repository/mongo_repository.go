@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+const (
+	productsDB           = "products"
+	categoriesCollection = "categories"
+	productsCollection   = "products"
+)
+
+// categoryMongoRepo
+type categoryMongoRepo struct {
+	mongoDB *mongo.Client
+}
+
+// NewCategoryMongoRepo categoryMongoRepo constructor
+func NewCategoryMongoRepo(mongoDB *mongo.Client) *categoryMongoRepo {
+	return &categoryMongoRepo{mongoDB: mongoDB}
+}
+
+// Create Create new category
+func (c *categoryMongoRepo) Create(ctx context.Context, category *models.Category) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.Create")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	category.CreatedAt = time.Now().UTC()
+	category.UpdatedAt = time.Now().UTC()
+
+	result, err := collection.InsertOne(ctx, category, &options.InsertOneOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "InsertOne")
+	}
+
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.Wrap(productErrors.ErrObjectIDTypeConversion, "result.InsertedID")
+	}
+
+	category.CategoryID = objectID
+
+	return category, nil
+}
+
+// Update Single category, parent and path are replaced as is
+func (c *categoryMongoRepo) Update(ctx context.Context, category *models.Category) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.Update")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	ops := options.FindOneAndUpdate()
+	ops.SetReturnDocument(options.After)
+
+	set := bson.M{
+		"name":        category.Name,
+		"description": category.Description,
+		"path":        category.Path,
+		"updatedAt":   time.Now().UTC(),
+	}
+	update := bson.M{"$set": set}
+	if category.IsRoot() {
+		update["$unset"] = bson.M{"parentId": ""}
+	} else {
+		set["parentId"] = category.ParentID
+	}
+
+	var cat models.Category
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": category.CategoryID}, update, ops).Decode(&cat); err != nil {
+		return nil, errors.Wrap(err, "Decode")
+	}
+
+	return &cat, nil
+}
+
+// GetByID Get single category by id
+func (c *categoryMongoRepo) GetByID(ctx context.Context, categoryID primitive.ObjectID) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.GetByID")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	var cat models.Category
+	if err := collection.FindOne(ctx, bson.M{"_id": categoryID}).Decode(&cat); err != nil {
+		return nil, errors.Wrap(err, "Decode")
+	}
+
+	return &cat, nil
+}
+
+// GetChildren Get direct children of category, root categories for zero parent id
+func (c *categoryMongoRepo) GetChildren(ctx context.Context, parentID primitive.ObjectID, pagination *utils.Pagination) (*models.CategoriesList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.GetChildren")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	f := bson.M{"parentId": parentID}
+	if parentID.IsZero() {
+		f = bson.M{"parentId": bson.M{"$exists": false}}
+	}
+
+	count, err := collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "CountDocuments")
+	}
+	if count == 0 {
+		return &models.CategoriesList{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Categories: make([]*models.Category, 0),
+		}, nil
+	}
+
+	limit := int64(pagination.GetLimit())
+	skip := int64(pagination.GetOffset())
+	cursor, err := collection.Find(ctx, f, &options.FindOptions{
+		Limit: &limit,
+		Skip:  &skip,
+		Sort:  bson.D{{Key: "name", Value: 1}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Find")
+	}
+	defer cursor.Close(ctx)
+
+	categories := make([]*models.Category, 0, pagination.GetSize())
+	for cursor.Next(ctx) {
+		var cat models.Category
+		if err := cursor.Decode(&cat); err != nil {
+			return nil, errors.Wrap(err, "Find")
+		}
+		categories = append(categories, &cat)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "cursor.Err")
+	}
+
+	return &models.CategoriesList{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Categories: categories,
+	}, nil
+}
+
+// GetDescendantIDs Get ids of all categories in subtree of category, category itself is not included
+func (c *categoryMongoRepo) GetDescendantIDs(ctx context.Context, categoryID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.GetDescendantIDs")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	cursor, err := collection.Find(ctx, bson.M{"path": categoryID}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, errors.Wrap(err, "Find")
+	}
+	defer cursor.Close(ctx)
+
+	ids := make([]primitive.ObjectID, 0)
+	for cursor.Next(ctx) {
+		var cat models.Category
+		if err := cursor.Decode(&cat); err != nil {
+			return nil, errors.Wrap(err, "Decode")
+		}
+		ids = append(ids, cat.CategoryID)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "cursor.Err")
+	}
+
+	return ids, nil
+}
+
+// UpdateDescendantsPath Rewrite path of all descendants after category was moved
+func (c *categoryMongoRepo) UpdateDescendantsPath(ctx context.Context, category *models.Category) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.UpdateDescendantsPath")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	cursor, err := collection.Find(ctx, bson.M{"path": category.CategoryID}, options.Find().SetProjection(bson.M{"_id": 1, "path": 1}))
+	if err != nil {
+		return errors.Wrap(err, "Find")
+	}
+	defer cursor.Close(ctx)
+
+	childPath := category.ChildPath()
+	writes := make([]mongo.WriteModel, 0)
+	for cursor.Next(ctx) {
+		var cat models.Category
+		if err := cursor.Decode(&cat); err != nil {
+			return errors.Wrap(err, "Decode")
+		}
+
+		for i, id := range cat.Path {
+			if id != category.CategoryID {
+				continue
+			}
+			path := make([]primitive.ObjectID, 0, len(childPath)+len(cat.Path)-i-1)
+			path = append(path, childPath...)
+			path = append(path, cat.Path[i+1:]...)
+
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": cat.CategoryID}).
+				SetUpdate(bson.M{"$set": bson.M{"path": path, "updatedAt": time.Now().UTC()}}))
+			break
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return errors.Wrap(err, "cursor.Err")
+	}
+	if len(writes) == 0 {
+		return nil
+	}
+
+	if _, err := collection.BulkWrite(ctx, writes); err != nil {
+		return errors.Wrap(err, "BulkWrite")
+	}
+
+	return nil
+}
+
+// CountProducts Count products referencing category, archived products are counted too
+func (c *categoryMongoRepo) CountProducts(ctx context.Context, categoryID primitive.ObjectID) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.CountProducts")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	count, err := collection.CountDocuments(ctx, bson.M{"categoryId": categoryID})
+	if err != nil {
+		return 0, errors.Wrap(err, "CountDocuments")
+	}
+
+	return count, nil
+}
+
+// Delete Delete single category by id
+func (c *categoryMongoRepo) Delete(ctx context.Context, categoryID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryMongoRepo.Delete")
+	defer span.Finish()
+
+	collection := c.mongoDB.Database(productsDB).Collection(categoriesCollection)
+
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": categoryID})
+	if err != nil {
+		return errors.Wrap(err, "DeleteOne")
+	}
+	if result.DeletedCount == 0 {
+		return errors.Wrap(mongo.ErrNoDocuments, "DeleteOne")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+const (
+	prefix     = "categories"
+	expiration = time.Second * 3600
+)
+
+type categoryRedisRepository struct {
+	prefix string
+	redis  *redis.Client
+}
+
+// NewCategoryRedisRepository constructor
+func NewCategoryRedisRepository(redis *redis.Client) *categoryRedisRepository {
+	return &categoryRedisRepository{redis: redis, prefix: prefix}
+}
+
+func (c *categoryRedisRepository) SetCategory(ctx context.Context, category *models.Category) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryRedisRepository.SetCategory")
+	defer span.Finish()
+
+	catBytes, err := json.Marshal(category)
+	if err != nil {
+		return errors.Wrap(err, "categoryRedisRepository.Marshal")
+	}
+
+	return c.redis.SetEX(ctx, c.createKey(category.CategoryID), string(catBytes), expiration).Err()
+}
+
+func (c *categoryRedisRepository) GetCategoryByID(ctx context.Context, categoryID primitive.ObjectID) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryRedisRepository.GetCategoryByID")
+	defer span.Finish()
+
+	result, err := c.redis.Get(ctx, c.createKey(categoryID)).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "categoryRedisRepository.redis.Get")
+	}
+
+	var res models.Category
+	if err := json.Unmarshal(result, &res); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+	return &res, nil
+}
+
+func (c *categoryRedisRepository) DeleteCategory(ctx context.Context, categoryID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryRedisRepository.DeleteCategory")
+	defer span.Finish()
+
+	return c.redis.Del(ctx, c.createKey(categoryID)).Err()
+}
+
+func (c *categoryRedisRepository) createKey(id primitive.ObjectID) string {
+	return fmt.Sprintf("%s: %s", c.prefix, id.String())
+}
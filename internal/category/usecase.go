@@ -0,0 +1,21 @@
+package category
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+// UseCase Category
+type UseCase interface {
+	Create(ctx context.Context, category *models.Category) (*models.Category, error)
+	Update(ctx context.Context, category *models.Category) (*models.Category, error)
+	GetByID(ctx context.Context, categoryID primitive.ObjectID) (*models.Category, error)
+	GetChildren(ctx context.Context, parentID primitive.ObjectID, pagination *utils.Pagination) (*models.CategoriesList, error)
+	Delete(ctx context.Context, categoryID primitive.ObjectID) error
+	Exists(ctx context.Context, categoryID primitive.ObjectID) error
+	GetSubtreeIDs(ctx context.Context, categoryID primitive.ObjectID) ([]primitive.ObjectID, error)
+}
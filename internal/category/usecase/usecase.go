@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/AleksK1NG/products-microservice/internal/category"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	categoryErrors "github.com/AleksK1NG/products-microservice/pkg/category_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+// categoryUC
+type categoryUC struct {
+	categoryRepo category.MongoRepository
+	redisRepo    category.RedisRepository
+	log          logger.Logger
+}
+
+// NewCategoryUC constructor
+func NewCategoryUC(categoryRepo category.MongoRepository, redisRepo category.RedisRepository, log logger.Logger) *categoryUC {
+	return &categoryUC{categoryRepo: categoryRepo, redisRepo: redisRepo, log: log}
+}
+
+// Create Create new category
+func (c *categoryUC) Create(ctx context.Context, category *models.Category) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.Create")
+	defer span.Finish()
+
+	path, err := c.getParentPath(ctx, category)
+	if err != nil {
+		return nil, errors.Wrap(err, "getParentPath")
+	}
+	category.Path = path
+
+	return c.categoryRepo.Create(ctx, category)
+}
+
+// Update Update single category, moving it to another parent rewrites paths of the whole subtree
+func (c *categoryUC) Update(ctx context.Context, category *models.Category) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.Update")
+	defer span.Finish()
+
+	existing, err := c.categoryRepo.GetByID(ctx, category.CategoryID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetByID")
+	}
+
+	moved := existing.ParentID != category.ParentID
+	category.Path = existing.Path
+	if moved {
+		path, err := c.getParentPath(ctx, category)
+		if err != nil {
+			return nil, errors.Wrap(err, "getParentPath")
+		}
+		category.Path = path
+	}
+
+	cat, err := c.categoryRepo.Update(ctx, category)
+	if err != nil {
+		return nil, errors.Wrap(err, "Update")
+	}
+
+	if moved {
+		if err := c.categoryRepo.UpdateDescendantsPath(ctx, cat); err != nil {
+			return nil, errors.Wrap(err, "UpdateDescendantsPath")
+		}
+		c.deleteSubtreeCache(ctx, cat.CategoryID)
+	}
+
+	if err := c.redisRepo.SetCategory(ctx, cat); err != nil {
+		c.log.Errorf("redisRepo.SetCategory: %v", err)
+	}
+
+	return cat, nil
+}
+
+// GetByID Get single category by id
+func (c *categoryUC) GetByID(ctx context.Context, categoryID primitive.ObjectID) (*models.Category, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.GetByID")
+	defer span.Finish()
+
+	cached, err := c.redisRepo.GetCategoryByID(ctx, categoryID)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		c.log.Errorf("redisRepo.GetCategoryByID: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	cat, err := c.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetByID")
+	}
+
+	if err := c.redisRepo.SetCategory(ctx, cat); err != nil {
+		c.log.Errorf("redisRepo.SetCategory: %v", err)
+	}
+
+	return cat, nil
+}
+
+// GetChildren Get direct children of category, root categories for zero parent id
+func (c *categoryUC) GetChildren(ctx context.Context, parentID primitive.ObjectID, pagination *utils.Pagination) (*models.CategoriesList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.GetChildren")
+	defer span.Finish()
+	return c.categoryRepo.GetChildren(ctx, parentID, pagination)
+}
+
+// Delete Delete single category, only categories without children and products can be deleted
+func (c *categoryUC) Delete(ctx context.Context, categoryID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.Delete")
+	defer span.Finish()
+
+	descendants, err := c.categoryRepo.GetDescendantIDs(ctx, categoryID)
+	if err != nil {
+		return errors.Wrap(err, "GetDescendantIDs")
+	}
+	if len(descendants) > 0 {
+		return categoryErrors.ErrCategoryNotEmpty
+	}
+
+	count, err := c.categoryRepo.CountProducts(ctx, categoryID)
+	if err != nil {
+		return errors.Wrap(err, "CountProducts")
+	}
+	if count > 0 {
+		return categoryErrors.ErrCategoryNotEmpty
+	}
+
+	if err := c.categoryRepo.Delete(ctx, categoryID); err != nil {
+		return errors.Wrap(err, "Delete")
+	}
+
+	if err := c.redisRepo.DeleteCategory(ctx, categoryID); err != nil {
+		c.log.Errorf("redisRepo.DeleteCategory: %v", err)
+	}
+
+	return nil
+}
+
+// Exists Check category exists, returns ErrCategoryNotFound for unknown category
+func (c *categoryUC) Exists(ctx context.Context, categoryID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.Exists")
+	defer span.Finish()
+
+	if _, err := c.GetByID(ctx, categoryID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return categoryErrors.ErrCategoryNotFound
+		}
+		return errors.Wrap(err, "GetByID")
+	}
+
+	return nil
+}
+
+// GetSubtreeIDs Get ids of category and all its descendants
+func (c *categoryUC) GetSubtreeIDs(ctx context.Context, categoryID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "categoryUC.GetSubtreeIDs")
+	defer span.Finish()
+
+	descendants, err := c.categoryRepo.GetDescendantIDs(ctx, categoryID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetDescendantIDs")
+	}
+
+	return append([]primitive.ObjectID{categoryID}, descendants...), nil
+}
+
+// getParentPath Validate category parent and build category path from it
+func (c *categoryUC) getParentPath(ctx context.Context, category *models.Category) ([]primitive.ObjectID, error) {
+	if category.IsRoot() {
+		return make([]primitive.ObjectID, 0), nil
+	}
+	if category.ParentID == category.CategoryID {
+		return nil, categoryErrors.ErrInvalidParentCategory
+	}
+
+	parent, err := c.GetByID(ctx, category.ParentID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, categoryErrors.ErrInvalidParentCategory
+		}
+		return nil, errors.Wrap(err, "GetByID")
+	}
+
+	for _, id := range parent.Path {
+		if id == category.CategoryID {
+			return nil, categoryErrors.ErrInvalidParentCategory
+		}
+	}
+
+	return parent.ChildPath(), nil
+}
+
+func (c *categoryUC) deleteSubtreeCache(ctx context.Context, categoryID primitive.ObjectID) {
+	descendants, err := c.categoryRepo.GetDescendantIDs(ctx, categoryID)
+	if err != nil {
+		c.log.Errorf("categoryRepo.GetDescendantIDs: %v", err)
+		return
+	}
+
+	for _, id := range descendants {
+		if err := c.redisRepo.DeleteCategory(ctx, id); err != nil {
+			c.log.Errorf("redisRepo.DeleteCategory: %v", err)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package deadletter
+
+import "github.com/labstack/echo/v4"
+
+// HttpDelivery http delivery
+type HttpDelivery interface {
+	ReplayDeadLetters() echo.HandlerFunc
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/AleksK1NG/products-microservice/internal/deadletter"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	grpcErrors "github.com/AleksK1NG/products-microservice/pkg/grpc_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	deadLettersService "github.com/AleksK1NG/products-microservice/proto/deadletter"
+)
+
+// deadLetterService gRPC Service
+type deadLetterService struct {
+	log          logger.Logger
+	deadLetterUC deadletter.UseCase
+}
+
+// NewDeadLetterService deadLetterService constructor
+func NewDeadLetterService(log logger.Logger, deadLetterUC deadletter.UseCase) *deadLetterService {
+	return &deadLetterService{log: log, deadLetterUC: deadLetterUC}
+}
+
+// Replay Start replay of dead letter messages matching request filter, returned operation has replay report as result
+func (d *deadLetterService) Replay(ctx context.Context, req *deadLettersService.ReplayReq) (*deadLettersService.ReplayRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "deadLetterService.Replay")
+	defer span.Finish()
+	replayMessages.Inc()
+
+	op, err := d.deadLetterUC.StartReplay(ctx, models.ReplayFilterFromProto(req))
+	if err != nil {
+		errorMessages.Inc()
+		d.log.Errorf("deadLetterUC.StartReplay: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &deadLettersService.ReplayRes{OperationID: op.OperationID}, nil
+}
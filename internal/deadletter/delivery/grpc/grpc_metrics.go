@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	successMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dead_letters_success_incoming_grpc_messages_total",
+		Help: "The total number of success incoming success gRPC messages",
+	})
+	errorMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dead_letters_error_incoming_grpc_message_total",
+		Help: "The total number of error incoming success gRPC messages",
+	})
+	replayMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dead_letters_replay_incoming_grpc_requests_total",
+		Help: "The total number of incoming replay dead letters gRPC messages",
+	})
+)
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/AleksK1NG/products-microservice/internal/deadletter"
+	"github.com/AleksK1NG/products-microservice/internal/middlewares"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	httpErrors "github.com/AleksK1NG/products-microservice/pkg/http_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+)
+
+// operationsPath path of operations resource tracking background replays
+const operationsPath = "/api/v1/operations/"
+
+type deadLetterHandlers struct {
+	log          logger.Logger
+	deadLetterUC deadletter.UseCase
+	validate     *validator.Validate
+	group        *echo.Group
+	mw           middlewares.MiddlewareManager
+}
+
+// NewDeadLetterHandlers constructor
+func NewDeadLetterHandlers(
+	log logger.Logger,
+	deadLetterUC deadletter.UseCase,
+	validate *validator.Validate,
+	group *echo.Group,
+	mw middlewares.MiddlewareManager,
+) *deadLetterHandlers {
+	return &deadLetterHandlers{log: log, deadLetterUC: deadLetterUC, validate: validate, group: group, mw: mw}
+}
+
+// ReplayDeadLetters Replay dead letter messages
+// @Tags Admin
+// @Summary Replay dead letter messages
+// @Description Republish original payloads of dead-letter-queue messages matching filter to create-product, update-product or delete-product,
+// @Description dry run only reports matching messages. Replay runs in background, returned operation has replay report as result
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "admin bearer token"
+// @Param filter body models.ReplayFilter true "replay filter"
+// @Success 202 {object} models.Operation
+// @Router /admin/dead-letters/replay [post]
+func (h *deadLetterHandlers) ReplayDeadLetters() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "deadLetterHandlers.Replay")
+		defer span.Finish()
+		replayRequests.Inc()
+
+		var filter models.ReplayFilter
+		if err := c.Bind(&filter); err != nil {
+			h.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &filter); err != nil {
+			h.log.Errorf("validate.StructCtx: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		op, err := h.deadLetterUC.StartReplay(ctx, &filter)
+		if err != nil {
+			h.log.Errorf("deadLetterUC.StartReplay: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		c.Response().Header().Set(echo.HeaderLocation, operationsPath+op.OperationID)
+		return c.JSON(http.StatusAccepted, op)
+	}
+}
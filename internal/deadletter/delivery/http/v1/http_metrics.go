@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	successRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_dead_letters_success_incoming_messages_total",
+		Help: "The total number of success incoming success HTTP requests",
+	})
+	errorRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_dead_letters_error_incoming_message_total",
+		Help: "The total number of error incoming success HTTP requests",
+	})
+	replayRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_dead_letters_replay_incoming_requests_total",
+		Help: "The total number of incoming replay dead letters HTTP requests",
+	})
+)
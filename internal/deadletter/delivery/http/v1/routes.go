@@ -0,0 +1,6 @@
+package v1
+
+// MapRoutes dead letters routes
+func (h *deadLetterHandlers) MapRoutes() {
+	h.group.POST("/replay", h.ReplayDeadLetters())
+}
@@ -0,0 +1,13 @@
+package deadletter
+
+import (
+	"context"
+	"time"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+// KafkaRepository DeadLetter
+type KafkaRepository interface {
+	Read(ctx context.Context, from *time.Time, handle func(msg *models.ErrorMessage) (bool, error)) error
+}
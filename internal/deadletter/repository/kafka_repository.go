@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/AleksK1NG/products-microservice/config"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	prodKafka "github.com/AleksK1NG/products-microservice/internal/product/delivery/kafka"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+)
+
+const (
+	minBytes = 10e3 // 10KB
+	maxBytes = 10e6 // 10MB
+)
+
+type deadLetterKafkaRepository struct {
+	log logger.Logger
+	cfg *config.Config
+}
+
+// NewDeadLetterKafkaRepository constructor
+func NewDeadLetterKafkaRepository(log logger.Logger, cfg *config.Config) *deadLetterKafkaRepository {
+	return &deadLetterKafkaRepository{log: log, cfg: cfg}
+}
+
+// Read Read dead letter queue partitions up to their end at the moment of call without committing offsets,
+// messages failed before from are skipped and reading stops when handle returns false
+func (d *deadLetterKafkaRepository) Read(ctx context.Context, from *time.Time, handle func(msg *models.ErrorMessage) (bool, error)) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "deadLetterKafkaRepository.Read")
+	defer span.Finish()
+
+	conn, err := kafka.DialContext(ctx, "tcp", d.cfg.Kafka.Brokers[0])
+	if err != nil {
+		return errors.Wrap(err, "kafka.DialContext")
+	}
+	defer conn.Close()
+
+	partitions, err := conn.ReadPartitions(prodKafka.DeadLetterQueueTopic)
+	if err != nil {
+		return errors.Wrap(err, "conn.ReadPartitions")
+	}
+
+	for _, partition := range partitions {
+		next, err := d.readPartition(ctx, partition.ID, from, handle)
+		if err != nil {
+			return errors.Wrapf(err, "partition: %d", partition.ID)
+		}
+		if !next {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (d *deadLetterKafkaRepository) readPartition(
+	ctx context.Context,
+	partition int,
+	from *time.Time,
+	handle func(msg *models.ErrorMessage) (bool, error),
+) (bool, error) {
+	leader, err := kafka.DialLeader(ctx, "tcp", d.cfg.Kafka.Brokers[0], prodKafka.DeadLetterQueueTopic, partition)
+	if err != nil {
+		return false, errors.Wrap(err, "kafka.DialLeader")
+	}
+	first, last, err := leader.ReadOffsets()
+	leader.Close()
+	if err != nil {
+		return false, errors.Wrap(err, "leader.ReadOffsets")
+	}
+	if first >= last {
+		return true, nil
+	}
+
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     d.cfg.Kafka.Brokers,
+		Topic:       prodKafka.DeadLetterQueueTopic,
+		Partition:   partition,
+		MinBytes:    minBytes,
+		MaxBytes:    maxBytes,
+		Logger:      kafka.LoggerFunc(d.log.Debugf),
+		ErrorLogger: kafka.LoggerFunc(d.log.Errorf),
+	})
+	defer r.Close()
+
+	if from != nil {
+		if err := r.SetOffsetAt(ctx, *from); err != nil {
+			return false, errors.Wrap(err, "r.SetOffsetAt")
+		}
+	} else if err := r.SetOffset(first); err != nil {
+		return false, errors.Wrap(err, "r.SetOffset")
+	}
+
+	for r.Offset() < last {
+		m, err := r.ReadMessage(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "r.ReadMessage")
+		}
+
+		var msg models.ErrorMessage
+		if err := json.Unmarshal(m.Value, &msg); err != nil {
+			d.log.Errorf("json.Unmarshal: offset %d: %v", m.Offset, err)
+		} else {
+			next, err := handle(&msg)
+			if err != nil || !next {
+				return false, err
+			}
+		}
+
+		if m.Offset >= last-1 {
+			break
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,13 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+// UseCase DeadLetter
+type UseCase interface {
+	Replay(ctx context.Context, filter *models.ReplayFilter, progress func(report *models.ReplayReport)) (*models.ReplayReport, error)
+	StartReplay(ctx context.Context, filter *models.ReplayFilter) (*models.Operation, error)
+}
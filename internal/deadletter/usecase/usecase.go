@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/deadletter"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/internal/operation"
+	prodKafka "github.com/AleksK1NG/products-microservice/internal/product/delivery/kafka"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+)
+
+const (
+	progressInterval = 100
+	stopTimeout      = 5 * time.Second
+
+	reasonDryRun           = "dry run"
+	reasonNoPayload        = "original payload is not stored"
+	reasonUnsupportedTopic = "topic can not be replayed"
+)
+
+// deadLetterUC
+type deadLetterUC struct {
+	// ctx lifetime of background replays, they are stopped when server shuts down
+	ctx          context.Context
+	kafkaRepo    deadletter.KafkaRepository
+	prodProducer prodKafka.ProductsProducer
+	operationUC  operation.UseCase
+	log          logger.Logger
+}
+
+// NewDeadLetterUC constructor, ctx bounds replays started by StartReplay and operationUC is used only by StartReplay
+func NewDeadLetterUC(
+	ctx context.Context,
+	kafkaRepo deadletter.KafkaRepository,
+	prodProducer prodKafka.ProductsProducer,
+	operationUC operation.UseCase,
+	log logger.Logger,
+) *deadLetterUC {
+	return &deadLetterUC{ctx: ctx, kafkaRepo: kafkaRepo, prodProducer: prodProducer, operationUC: operationUC, log: log}
+}
+
+// Replay Republish original payloads of dead letter messages matching filter to their topics,
+// dry run only reports matching messages and progress is called every progressInterval scanned messages
+func (d *deadLetterUC) Replay(
+	ctx context.Context,
+	filter *models.ReplayFilter,
+	progress func(report *models.ReplayReport),
+) (*models.ReplayReport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "deadLetterUC.Replay")
+	defer span.Finish()
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &models.ReplayReport{DryRun: filter.DryRun, Messages: make([]*models.ReplayedMessage, 0)}
+	if err := d.kafkaRepo.Read(ctx, filter.From, func(msg *models.ErrorMessage) (bool, error) {
+		report.Scanned++
+		if progress != nil && report.Scanned%progressInterval == 0 {
+			progress(report)
+		}
+		if !filter.Match(msg) {
+			return true, nil
+		}
+
+		report.Matched++
+		report.AddMessage(d.replayMessage(ctx, msg, filter.DryRun, report))
+
+		return filter.Limit == 0 || report.Matched < filter.Limit, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "kafkaRepo.Read")
+	}
+
+	if progress != nil {
+		progress(report)
+	}
+	return report, nil
+}
+
+// StartReplay Replay dead letter messages matching filter in background, returned operation has report as result
+// which is updated every progressInterval scanned messages
+func (d *deadLetterUC) StartReplay(ctx context.Context, filter *models.ReplayFilter) (*models.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "deadLetterUC.StartReplay")
+	defer span.Finish()
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	op, err := d.operationUC.Create(ctx, models.OperationTypeReplayDeadLetters, primitive.NilObjectID)
+	if err != nil {
+		return nil, errors.Wrap(err, "operationUC.Create")
+	}
+
+	go d.runReplay(op.OperationID, filter)
+
+	return op, nil
+}
+
+// runReplay Replay dead letters and store report in operation, replay outlives request which started it
+// and is stopped when use case context is done
+func (d *deadLetterUC) runReplay(operationID string, filter *models.ReplayFilter) {
+	span := opentracing.StartSpan("deadLetterUC.runReplay")
+	defer span.Finish()
+	ctx := opentracing.ContextWithSpan(d.ctx, span)
+
+	report, err := d.Replay(ctx, filter, func(report *models.ReplayReport) {
+		if err := d.operationUC.SetResult(ctx, operationID, report); err != nil {
+			d.log.Errorf("operationUC.SetResult: %v", err)
+		}
+	})
+	if err != nil {
+		d.log.Errorf("Replay: operation %s: %v", operationID, err)
+		// stopped replay is still reported, so operation does not stay pending
+		ctx, cancel := context.WithTimeout(opentracing.ContextWithSpan(context.Background(), span), stopTimeout)
+		defer cancel()
+		if err := d.operationUC.SetFailed(ctx, operationID, err); err != nil {
+			d.log.Errorf("operationUC.SetFailed: %v", err)
+		}
+		return
+	}
+
+	d.log.Infof("dead letters replay operation %s is done: %s", operationID, report)
+	if err := d.operationUC.SetSucceeded(ctx, operationID); err != nil {
+		d.log.Errorf("operationUC.SetSucceeded: %v", err)
+	}
+}
+
+// replayMessage Publish original message and count result in report
+func (d *deadLetterUC) replayMessage(ctx context.Context, msg *models.ErrorMessage, dryRun bool, report *models.ReplayReport) *models.ReplayedMessage {
+	replayed := &models.ReplayedMessage{
+		MessageID: msg.MessageID,
+		Topic:     msg.Topic,
+		Error:     msg.Error,
+		FailedAt:  msg.GetFailedAt(),
+	}
+
+	publish := d.getPublisher(msg.Topic)
+	switch {
+	case publish == nil:
+		report.Skipped++
+		replayed.Reason = reasonUnsupportedTopic
+		return replayed
+	case len(msg.Value) == 0:
+		report.Skipped++
+		replayed.Reason = reasonNoPayload
+		return replayed
+	case dryRun:
+		replayed.Reason = reasonDryRun
+		return replayed
+	}
+
+	// replayed message starts with fresh retry attempts
+	headers := make([]kafka.Header, 0, len(msg.Headers)+1)
+	for _, header := range msg.Headers {
+		switch header.Key {
+		case prodKafka.ReplayedFromHeader, prodKafka.RetryAttemptHeader, prodKafka.RetryDueAtHeader, prodKafka.OriginalTopicHeader:
+			continue
+		}
+		headers = append(headers, kafka.Header{Key: header.Key, Value: header.Value})
+	}
+	headers = append(headers, kafka.Header{Key: prodKafka.ReplayedFromHeader, Value: []byte(msg.MessageID)})
+
+	if err := publish(ctx, kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+		Time:    time.Now().UTC(),
+	}); err != nil {
+		d.log.Errorf("publish: message %s: %v", msg.MessageID, err)
+		report.Failed++
+		replayed.Reason = err.Error()
+		return replayed
+	}
+
+	report.Replayed++
+	replayed.Replayed = true
+	return replayed
+}
+
+// getPublisher Get producer method of original topic, nil for topics which can not be replayed
+func (d *deadLetterUC) getPublisher(topic string) func(ctx context.Context, msgs ...kafka.Message) error {
+	switch topic {
+	case prodKafka.CreateProductTopic:
+		return d.prodProducer.PublishCreate
+	case prodKafka.UpdateProductTopic:
+		return d.prodProducer.PublishUpdate
+	case prodKafka.DeleteProductTopic:
+		return d.prodProducer.PublishDelete
+	default:
+		return nil
+	}
+}
@@ -2,6 +2,8 @@ package interceptors
 
 import (
 	"context"
+	"crypto/subtle"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -10,9 +12,13 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/AleksK1NG/products-microservice/config"
+	grpcErrors "github.com/AleksK1NG/products-microservice/pkg/grpc_errors"
 	"github.com/AleksK1NG/products-microservice/pkg/logger"
 )
 
+// adminServicePrefix methods of admin services require admin bearer token in authorization metadata
+const adminServicePrefix = "/deadLettersService."
+
 var (
 	totalRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "products_service_requests_total",
@@ -46,3 +52,31 @@ func (im *InterceptorManager) Logger(
 
 	return reply, err
 }
+
+// AdminAuth Interceptor allow admin services calls only with configured admin bearer token
+func (im *InterceptorManager) AdminAuth(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	if !strings.HasPrefix(info.FullMethod, adminServicePrefix) {
+		return handler(ctx, req)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, grpcErrors.ErrorResponse(grpcErrors.ErrNoCtxMetaData, "metadata.FromIncomingContext")
+	}
+
+	var token string
+	if values := md.Get("authorization"); len(values) > 0 {
+		token = strings.TrimPrefix(values[0], "Bearer ")
+	}
+	if im.cfg.Admin.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(im.cfg.Admin.Token)) != 1 {
+		im.logger.Errorf("AdminAuth: invalid admin token, method: %s", info.FullMethod)
+		return nil, grpcErrors.ErrorResponse(grpcErrors.ErrInvalidAdminToken, "AdminAuth")
+	}
+
+	return handler(ctx, req)
+}
@@ -1,11 +1,15 @@
 package middlewares
 
 import (
+	"crypto/subtle"
+	"strings"
+
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/AleksK1NG/products-microservice/config"
+	httpErrors "github.com/AleksK1NG/products-microservice/pkg/http_errors"
 	"github.com/AleksK1NG/products-microservice/pkg/logger"
 )
 
@@ -25,6 +29,7 @@ type middlewareManager struct {
 // MiddlewareManager interface
 type MiddlewareManager interface {
 	Metrics(next echo.HandlerFunc) echo.HandlerFunc
+	AdminAuth(next echo.HandlerFunc) echo.HandlerFunc
 }
 
 // NewMiddlewareManager constructor
@@ -39,3 +44,15 @@ func (m *middlewareManager) Metrics(next echo.HandlerFunc) echo.HandlerFunc {
 		return next(c)
 	}
 }
+
+// AdminAuth allow only requests with configured admin bearer token
+func (m *middlewareManager) AdminAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token := strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if m.cfg.Admin.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(m.cfg.Admin.Token)) != 1 {
+			m.log.Errorf("AdminAuth: invalid admin token, path: %s", c.Path())
+			return httpErrors.ErrorCtxResponse(c, httpErrors.Unauthorized)
+		}
+		return next(c)
+	}
+}
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+	productsService "github.com/AleksK1NG/products-microservice/proto/product"
+)
+
+// BulkMaxItems max number of products written by single bulk request
+const BulkMaxItems = 1000
+
+// ValidateBulkSize Check number of products of bulk request
+func ValidateBulkSize(size int) error {
+	if size == 0 || size > BulkMaxItems {
+		return errors.Wrapf(productErrors.ErrInvalidBulkSize, "size: %d, max: %d", size, BulkMaxItems)
+	}
+	return nil
+}
+
+// ValidateBulkProductIDs Get errors by index of bulk update products without id or given more than once,
+// result of each write is tracked by product id so every occurrence of repeated id is failed
+func ValidateBulkProductIDs(products []*Product) map[int]error {
+	failed := make(map[int]error)
+	indexes := make(map[primitive.ObjectID][]int, len(products))
+	for i, product := range products {
+		if product.ProductID.IsZero() {
+			failed[i] = productErrors.ErrMissingBulkProductID
+			continue
+		}
+		indexes[product.ProductID] = append(indexes[product.ProductID], i)
+	}
+
+	for productID, idx := range indexes {
+		if len(idx) < 2 {
+			continue
+		}
+		for _, i := range idx {
+			failed[i] = errors.Wrapf(productErrors.ErrDuplicateBulkProduct, "product id: %s, indexes: %v", productID.Hex(), idx)
+		}
+	}
+	return failed
+}
+
+// BulkItemResult result of single product of bulk write, index is position of product in request
+type BulkItemResult struct {
+	Index     int                `json:"index"`
+	ProductID primitive.ObjectID `json:"productId"`
+	Success   bool               `json:"success"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// BulkResult per product results of bulk write in request order
+type BulkResult struct {
+	Items     []*BulkItemResult `json:"items"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+}
+
+// NewBulkResult Get bulk result for given number of products
+func NewBulkResult(size int) *BulkResult {
+	items := make([]*BulkItemResult, 0, size)
+	for i := 0; i < size; i++ {
+		items = append(items, &BulkItemResult{Index: i})
+	}
+	return &BulkResult{Items: items}
+}
+
+// SetSucceeded Mark product at index as written
+func (r *BulkResult) SetSucceeded(index int, productID primitive.ObjectID) {
+	r.set(&BulkItemResult{Index: index, ProductID: productID, Success: true})
+}
+
+// SetFailed Mark product at index as failed
+func (r *BulkResult) SetFailed(index int, productID primitive.ObjectID, err error) {
+	r.set(&BulkItemResult{Index: index, ProductID: productID, Error: err.Error()})
+}
+
+// Merge Copy results of written subset of products, indexes maps subset position to position in r
+func (r *BulkResult) Merge(indexes []int, subset *BulkResult) {
+	for i, item := range subset.Items {
+		r.set(&BulkItemResult{
+			Index:     indexes[i],
+			ProductID: item.ProductID,
+			Success:   item.Success,
+			Error:     item.Error,
+		})
+	}
+}
+
+// Append Add results of next chunk of products, indexes are shifted after existing results
+func (r *BulkResult) Append(chunk *BulkResult) {
+	offset := len(r.Items)
+	for _, item := range chunk.Items {
+		r.Items = append(r.Items, &BulkItemResult{Index: offset + item.Index})
+		r.set(&BulkItemResult{
+			Index:     offset + item.Index,
+			ProductID: item.ProductID,
+			Success:   item.Success,
+			Error:     item.Error,
+		})
+	}
+}
+
+// set Replace result of item at its index and keep counters of succeeded and failed items
+func (r *BulkResult) set(item *BulkItemResult) {
+	r.add(r.Items[item.Index], -1)
+	r.Items[item.Index] = item
+	r.add(item, 1)
+}
+
+func (r *BulkResult) add(item *BulkItemResult, delta int) {
+	if item.Success {
+		r.Succeeded += delta
+	} else if item.Error != "" {
+		r.Failed += delta
+	}
+}
+
+// ToProto Convert bulk result to proto
+func (r *BulkResult) ToProto() *productsService.BulkCreateRes {
+	items := make([]*productsService.BulkItemResult, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, &productsService.BulkItemResult{
+			Index:     int64(item.Index),
+			ProductID: item.ProductID.Hex(),
+			Success:   item.Success,
+			Error:     item.Error,
+		})
+	}
+	return &productsService.BulkCreateRes{Items: items, Succeeded: int64(r.Succeeded), Failed: int64(r.Failed)}
+}
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+)
+
+func TestValidateBulkProductIDs(t *testing.T) {
+	first := primitive.NewObjectID()
+	second := primitive.NewObjectID()
+
+	tests := []struct {
+		name   string
+		ids    []primitive.ObjectID
+		failed map[int]error
+	}{
+		{
+			name:   "unique ids",
+			ids:    []primitive.ObjectID{first, second},
+			failed: map[int]error{},
+		},
+		{
+			name:   "missing id",
+			ids:    []primitive.ObjectID{first, primitive.NilObjectID},
+			failed: map[int]error{1: productErrors.ErrMissingBulkProductID},
+		},
+		{
+			name: "repeated id fails every occurrence",
+			ids:  []primitive.ObjectID{first, second, first},
+			failed: map[int]error{
+				0: productErrors.ErrDuplicateBulkProduct,
+				2: productErrors.ErrDuplicateBulkProduct,
+			},
+		},
+		{
+			name: "missing ids are not duplicates",
+			ids:  []primitive.ObjectID{primitive.NilObjectID, primitive.NilObjectID, second},
+			failed: map[int]error{
+				0: productErrors.ErrMissingBulkProductID,
+				1: productErrors.ErrMissingBulkProductID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := make([]*Product, 0, len(tt.ids))
+			for _, id := range tt.ids {
+				products = append(products, &Product{ProductID: id})
+			}
+
+			failed := ValidateBulkProductIDs(products)
+			if len(failed) != len(tt.failed) {
+				t.Fatalf("failed = %v, want %v", failed, tt.failed)
+			}
+			for i, want := range tt.failed {
+				if !errors.Is(failed[i], want) {
+					t.Errorf("failed[%d] = %v, want %v", i, failed[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBulkResultMerge(t *testing.T) {
+	ids := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()}
+
+	result := NewBulkResult(4)
+	result.SetFailed(1, primitive.NilObjectID, productErrors.ErrInvalidBulkSize)
+
+	subset := NewBulkResult(3)
+	subset.SetSucceeded(0, ids[0])
+	subset.SetFailed(1, ids[1], productErrors.ErrProductNotFound)
+	subset.SetSucceeded(2, ids[2])
+	result.Merge([]int{0, 2, 3}, subset)
+
+	tests := []struct {
+		index     int
+		productID primitive.ObjectID
+		success   bool
+	}{
+		{index: 0, productID: ids[0], success: true},
+		{index: 1, productID: primitive.NilObjectID},
+		{index: 2, productID: ids[1]},
+		{index: 3, productID: ids[2], success: true},
+	}
+	for _, tt := range tests {
+		item := result.Items[tt.index]
+		if item.Index != tt.index || item.ProductID != tt.productID || item.Success != tt.success {
+			t.Errorf("item = %+v, want index %d, product %s, success %v", item, tt.index, tt.productID.Hex(), tt.success)
+		}
+		if !item.Success && item.Error == "" {
+			t.Errorf("item %d has no error", tt.index)
+		}
+	}
+	if result.Succeeded != 2 || result.Failed != 2 {
+		t.Errorf("succeeded, failed = %d, %d, want 2, 2", result.Succeeded, result.Failed)
+	}
+
+	// item written again replaces its result instead of being counted twice
+	result.SetSucceeded(2, ids[1])
+	if result.Succeeded != 3 || result.Failed != 1 {
+		t.Errorf("after overwrite succeeded, failed = %d, %d, want 3, 1", result.Succeeded, result.Failed)
+	}
+}
+
+func TestBulkResultAppend(t *testing.T) {
+	tests := []struct {
+		name      string
+		chunks    [][]bool
+		succeeded int
+		failed    int
+	}{
+		{name: "no chunks"},
+		{name: "single chunk", chunks: [][]bool{{true, false}}, succeeded: 1, failed: 1},
+		{name: "indexes are shifted", chunks: [][]bool{{true, true}, {false}, {true, false, true}}, succeeded: 4, failed: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewBulkResult(0)
+			total := 0
+			for _, outcomes := range tt.chunks {
+				chunk := NewBulkResult(len(outcomes))
+				for i, success := range outcomes {
+					if success {
+						chunk.SetSucceeded(i, primitive.NewObjectID())
+						continue
+					}
+					chunk.SetFailed(i, primitive.NilObjectID, productErrors.ErrProductNotFound)
+				}
+				result.Append(chunk)
+				total += len(outcomes)
+			}
+
+			if len(result.Items) != total {
+				t.Fatalf("items = %d, want %d", len(result.Items), total)
+			}
+			for i, item := range result.Items {
+				if item.Index != i {
+					t.Errorf("items[%d].Index = %d", i, item.Index)
+				}
+			}
+			if result.Succeeded != tt.succeeded || result.Failed != tt.failed {
+				t.Errorf("succeeded, failed = %d, %d, want %d, %d", result.Succeeded, result.Failed, tt.succeeded, tt.failed)
+			}
+		})
+	}
+}
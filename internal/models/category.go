@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	categoriesService "github.com/AleksK1NG/products-microservice/proto/category"
+)
+
+// Category models
+type Category struct {
+	CategoryID  primitive.ObjectID   `json:"categoryId" bson:"_id,omitempty"`
+	ParentID    primitive.ObjectID   `json:"parentId,omitempty" bson:"parentId,omitempty"`
+	Name        string               `json:"name,omitempty" bson:"name,omitempty" validate:"required,min=2,max=250"`
+	Description string               `json:"description,omitempty" bson:"description,omitempty" validate:"omitempty,max=500"`
+	Path        []primitive.ObjectID `json:"path" bson:"path"`
+	CreatedAt   time.Time            `json:"createdAt" bson:"createdAt,omitempty"`
+	UpdatedAt   time.Time            `json:"updatedAt" bson:"updatedAt,omitempty"`
+}
+
+// IsRoot Check if category has no parent
+func (c *Category) IsRoot() bool {
+	return c.ParentID.IsZero()
+}
+
+// ChildPath Get path of direct children of category
+func (c *Category) ChildPath() []primitive.ObjectID {
+	path := make([]primitive.ObjectID, 0, len(c.Path)+1)
+	path = append(path, c.Path...)
+	return append(path, c.CategoryID)
+}
+
+// ToProto Convert category to proto
+func (c *Category) ToProto() *categoriesService.Category {
+	path := make([]string, 0, len(c.Path))
+	for _, id := range c.Path {
+		path = append(path, id.Hex())
+	}
+
+	var parentID string
+	if !c.IsRoot() {
+		parentID = c.ParentID.Hex()
+	}
+
+	return &categoriesService.Category{
+		CategoryID:  c.CategoryID.Hex(),
+		ParentID:    parentID,
+		Name:        c.Name,
+		Description: c.Description,
+		Path:        path,
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// CategoriesList Categories response with pagination
+type CategoriesList struct {
+	TotalCount int64       `json:"totalCount"`
+	TotalPages int64       `json:"totalPages"`
+	Page       int64       `json:"page"`
+	Size       int64       `json:"size"`
+	HasMore    bool        `json:"hasMore"`
+	Categories []*Category `json:"categories"`
+}
+
+// ToProtoList convert categories list to proto
+func (c *CategoriesList) ToProtoList() []*categoriesService.Category {
+	categoriesList := make([]*categoriesService.Category, 0, len(c.Categories))
+	for _, category := range c.Categories {
+		categoriesList = append(categoriesList, category.ToProto())
+	}
+	return categoriesList
+}
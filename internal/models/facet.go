@@ -0,0 +1,70 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	productsService "github.com/AleksK1NG/products-microservice/proto/product"
+)
+
+const (
+	ProductFacetCategory = "category"
+	ProductFacetPrice    = "price"
+	ProductFacetRating   = "rating"
+)
+
+// ProductFacetNames facets which can be requested with search
+var ProductFacetNames = map[string]bool{
+	ProductFacetCategory: true,
+	ProductFacetPrice:    true,
+	ProductFacetRating:   true,
+}
+
+// CategoryFacet number of products of category matching search
+type CategoryFacet struct {
+	CategoryID primitive.ObjectID `json:"categoryId,omitempty" bson:"_id"`
+	Count      int64              `json:"count" bson:"count"`
+}
+
+// RangeFacet number of products with value in [From, To) range, the last bucket includes To
+type RangeFacet struct {
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Count int64   `json:"count"`
+}
+
+// ProductFacets facet counts of search, only requested facets are set
+type ProductFacets struct {
+	Categories []*CategoryFacet `json:"categories,omitempty"`
+	Price      []*RangeFacet    `json:"price,omitempty"`
+	Rating     []*RangeFacet    `json:"rating,omitempty"`
+}
+
+// ToProto Convert facets to proto
+func (f *ProductFacets) ToProto() *productsService.ProductFacets {
+	if f == nil {
+		return nil
+	}
+
+	categories := make([]*productsService.CategoryFacet, 0, len(f.Categories))
+	for _, c := range f.Categories {
+		categoryID := ""
+		if !c.CategoryID.IsZero() {
+			categoryID = c.CategoryID.Hex()
+		}
+		categories = append(categories, &productsService.CategoryFacet{CategoryID: categoryID, Count: c.Count})
+	}
+
+	return &productsService.ProductFacets{
+		Categories: categories,
+		Price:      rangeFacetsToProto(f.Price),
+		Rating:     rangeFacetsToProto(f.Rating),
+	}
+}
+
+func rangeFacetsToProto(facets []*RangeFacet) []*productsService.RangeFacet {
+	res := make([]*productsService.RangeFacet, 0, len(facets))
+	for _, r := range facets {
+		res = append(res, &productsService.RangeFacet{From: r.From, To: r.To, Count: r.Count})
+	}
+	return res
+}
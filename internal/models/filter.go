@@ -0,0 +1,134 @@
+package models
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+	productsService "github.com/AleksK1NG/products-microservice/proto/product"
+)
+
+// ProductsFilter structured search filter, nil bounds are not applied
+type ProductsFilter struct {
+	MinPrice        *float64             `json:"minPrice,omitempty"`
+	MaxPrice        *float64             `json:"maxPrice,omitempty"`
+	MinRating       *int                 `json:"minRating,omitempty"`
+	InStock         bool                 `json:"inStock,omitempty"`
+	CategoryIDs     []primitive.ObjectID `json:"categoryIds,omitempty"`
+	CreatedFrom     *time.Time           `json:"createdFrom,omitempty"`
+	CreatedTo       *time.Time           `json:"createdTo,omitempty"`
+	UpdatedFrom     *time.Time           `json:"updatedFrom,omitempty"`
+	UpdatedTo       *time.Time           `json:"updatedTo,omitempty"`
+	IncludeArchived bool                 `json:"includeArchived,omitempty"`
+	Language        string               `json:"language,omitempty"`
+	// Facets names of facet counts returned with search, does not narrow results
+	Facets []string `json:"facets,omitempty"`
+}
+
+// TextSearchLanguages languages supported by MongoDB text search, none disables stemming and stop words
+var TextSearchLanguages = map[string]bool{
+	"none":       true,
+	"danish":     true,
+	"dutch":      true,
+	"english":    true,
+	"finnish":    true,
+	"french":     true,
+	"german":     true,
+	"hungarian":  true,
+	"italian":    true,
+	"norwegian":  true,
+	"portuguese": true,
+	"romanian":   true,
+	"russian":    true,
+	"spanish":    true,
+	"swedish":    true,
+	"turkish":    true,
+}
+
+// Validate Check filter ranges
+func (f *ProductsFilter) Validate() error {
+	if f.MinPrice != nil && f.MaxPrice != nil && *f.MinPrice > *f.MaxPrice {
+		return errors.Wrap(productErrors.ErrInvalidFilter, "minPrice is greater than maxPrice")
+	}
+	if f.MinRating != nil && (*f.MinRating < 0 || *f.MinRating > 10) {
+		return errors.Wrap(productErrors.ErrInvalidFilter, "minRating must be between 0 and 10")
+	}
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return errors.Wrap(productErrors.ErrInvalidFilter, "createdFrom is after createdTo")
+	}
+	if f.UpdatedFrom != nil && f.UpdatedTo != nil && f.UpdatedFrom.After(*f.UpdatedTo) {
+		return errors.Wrap(productErrors.ErrInvalidFilter, "updatedFrom is after updatedTo")
+	}
+	if f.Language != "" && !TextSearchLanguages[f.Language] {
+		return errors.Wrapf(productErrors.ErrInvalidFilter, "unsupported language: %q", f.Language)
+	}
+	for _, facet := range f.Facets {
+		if !ProductFacetNames[facet] {
+			return errors.Wrapf(productErrors.ErrInvalidFilter, "unsupported facet: %q", facet)
+		}
+	}
+	return nil
+}
+
+// HasFacet Check if facet is requested
+func (f *ProductsFilter) HasFacet(name string) bool {
+	for _, facet := range f.Facets {
+		if facet == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ProductsFilterFromProto Get ProductsFilter from proto, nil filter matches all products
+func ProductsFilterFromProto(
+	filter *productsService.SearchFilter,
+	includeArchived bool,
+	language string,
+	facets []string,
+) (*ProductsFilter, error) {
+	f := &ProductsFilter{IncludeArchived: includeArchived, Language: language, Facets: facets}
+	if filter == nil {
+		return f, nil
+	}
+
+	if filter.GetMinPrice() != nil {
+		minPrice := filter.GetMinPrice().GetValue()
+		f.MinPrice = &minPrice
+	}
+	if filter.GetMaxPrice() != nil {
+		maxPrice := filter.GetMaxPrice().GetValue()
+		f.MaxPrice = &maxPrice
+	}
+	if filter.GetMinRating() != nil {
+		minRating := int(filter.GetMinRating().GetValue())
+		f.MinRating = &minRating
+	}
+	f.InStock = filter.GetInStock()
+
+	for _, id := range filter.GetCategoryIDs() {
+		catID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, err
+		}
+		f.CategoryIDs = append(f.CategoryIDs, catID)
+	}
+
+	f.CreatedFrom = timeFromProto(filter.GetCreatedFrom())
+	f.CreatedTo = timeFromProto(filter.GetCreatedTo())
+	f.UpdatedFrom = timeFromProto(filter.GetUpdatedFrom())
+	f.UpdatedTo = timeFromProto(filter.GetUpdatedTo())
+
+	return f, nil
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IdempotencyKeyRules validation rules of client provided idempotency key
+const IdempotencyKeyRules = "omitempty,printascii,max=255"
+
+// IdempotencyRecord result of create request stored by idempotency key,
+// empty OperationID of asynchronous create means request is still being published
+type IdempotencyRecord struct {
+	Key       string             `json:"key"`
+	ProductID primitive.ObjectID `json:"productId"`
+	// Fingerprint hash of request product, request repeated with the same key must have the same fingerprint
+	Fingerprint string    `json:"fingerprint"`
+	OperationID string    `json:"operationId,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// GetFingerprint Get hash of client provided product fields, id and fields maintained by service are not included
+func (p *Product) GetFingerprint() (string, error) {
+	fields := make(map[string]interface{}, len(ProductReplaceFields))
+	for _, field := range ProductReplaceFields {
+		value := p.FieldValue(field)
+		if photos, ok := value.([]string); ok && len(photos) == 0 {
+			value = nil
+		}
+		fields[field] = value
+	}
+
+	fieldsBytes, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(fieldsBytes)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -1,13 +1,55 @@
 package models
 
-import "time"
+import (
+	"time"
 
-// ErrorMessage
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// FailureReasonDecode message value can not be decoded
+	FailureReasonDecode = "DECODE"
+	// FailureReasonValidation decoded message is not valid
+	FailureReasonValidation = "VALIDATION"
+	// FailureReasonBusiness message is rejected by business rules, e.g. product not found or version conflict
+	FailureReasonBusiness = "BUSINESS"
+	// FailureReasonTransient processing failed because of temporary error, e.g. database is not available
+	FailureReasonTransient = "TRANSIENT"
+)
+
+// ErrorMessage dead letter record of message failed by consumer, key and value are original message bytes
 type ErrorMessage struct {
-	MessageID string    `json:"messageId"`
-	Offset    int64     `json:"offset"`
-	Partition int       `json:"partition"`
-	Topic     string    `json:"topic"`
-	Error     string    `json:"error"`
-	Time      time.Time `json:"time"`
+	MessageID     string               `json:"messageId"`
+	Offset        int64                `json:"offset"`
+	Partition     int                  `json:"partition"`
+	Topic         string               `json:"topic"`
+	Error         string               `json:"error"`
+	Reason        string               `json:"reason"`
+	Time          time.Time            `json:"time"`
+	Key           []byte               `json:"key"`
+	Value         []byte               `json:"value"`
+	Headers       []ErrorMessageHeader `json:"headers"`
+	Attempts      int                  `json:"attempts"`
+	ConsumerGroup string               `json:"consumerGroup"`
+	WorkerID      int                  `json:"workerId"`
+	FailedAt      time.Time            `json:"failedAt"`
+}
+
+// GetFailedAt Get time of failure, records published before FailedAt was added have only message time
+func (e *ErrorMessage) GetFailedAt() time.Time {
+	if e.FailedAt.IsZero() {
+		return e.Time
+	}
+	return e.FailedAt
+}
+
+// ErrorMessageHeader header of original message
+type ErrorMessageHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// DeleteProductMessage
+type DeleteProductMessage struct {
+	ProductID primitive.ObjectID `json:"productId" validate:"required"`
 }
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	operationsService "github.com/AleksK1NG/products-microservice/proto/operation"
+)
+
+const (
+	OperationStatusPending   = "pending"
+	OperationStatusSucceeded = "succeeded"
+	OperationStatusFailed    = "failed"
+
+	OperationTypeCreateProduct = "create_product"
+	OperationTypeUpdateProduct = "update_product"
+	OperationTypeDeleteProduct = "delete_product"
+
+	OperationTypeReplayDeadLetters = "replay_dead_letters"
+)
+
+// Operation status of asynchronous product write processed by Kafka consumers or of background admin task,
+// result is progress or outcome of task, e.g. dead letters replay report
+type Operation struct {
+	OperationID string             `json:"operationId"`
+	Type        string             `json:"type"`
+	ProductID   primitive.ObjectID `json:"productId"`
+	Status      string             `json:"status"`
+	Error       string             `json:"error,omitempty"`
+	Result      json.RawMessage    `json:"result,omitempty" swaggertype:"object"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+// IsDone Check if operation is processed
+func (o *Operation) IsDone() bool {
+	return o.Status != OperationStatusPending
+}
+
+// ToProto Convert operation to proto
+func (o *Operation) ToProto() *operationsService.Operation {
+	return &operationsService.Operation{
+		OperationID: o.OperationID,
+		Type:        o.Type,
+		ProductID:   o.ProductID.Hex(),
+		Status:      o.Status,
+		Error:       o.Error,
+		Result:      string(o.Result),
+		CreatedAt:   timestamppb.New(o.CreatedAt),
+		UpdatedAt:   timestamppb.New(o.UpdatedAt),
+	}
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+)
+
+const (
+	ProductFieldCategoryID  = "categoryId"
+	ProductFieldName        = "name"
+	ProductFieldDescription = "description"
+	ProductFieldPrice       = "price"
+	ProductFieldImageURL    = "imageUrl"
+	ProductFieldPhotos      = "photos"
+	ProductFieldQuantity    = "quantity"
+	ProductFieldRating      = "rating"
+)
+
+// ProductPatchRules validation rules of fields which can be partially updated, zero price and quantity are allowed
+var ProductPatchRules = map[string]string{
+	ProductFieldCategoryID:  "",
+	ProductFieldName:        "required,min=3,max=250",
+	ProductFieldDescription: "required,min=3,max=500",
+	ProductFieldPrice:       "gte=0",
+	ProductFieldImageURL:    "",
+	ProductFieldPhotos:      "",
+	ProductFieldQuantity:    "gte=0",
+	ProductFieldRating:      "min=0,max=10",
+}
+
+// ProductReplaceFields all fields written by create or replace
+var ProductReplaceFields = []string{
+	ProductFieldCategoryID,
+	ProductFieldName,
+	ProductFieldDescription,
+	ProductFieldPrice,
+	ProductFieldImageURL,
+	ProductFieldPhotos,
+	ProductFieldQuantity,
+	ProductFieldRating,
+}
+
+// ParseProductMask Map field mask paths to product fields, paths are case insensitive so both
+// proto (Price) and json (price) names are accepted
+func ParseProductMask(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, errors.Wrap(productErrors.ErrInvalidFieldMask, "empty field mask")
+	}
+
+	fields := make([]string, 0, len(paths))
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		field, ok := findPatchField(path)
+		if !ok {
+			return nil, errors.Wrapf(productErrors.ErrInvalidFieldMask, "field can not be updated: %q", path)
+		}
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
+
+func findPatchField(path string) (string, bool) {
+	for field := range ProductPatchRules {
+		if strings.EqualFold(field, path) {
+			return field, true
+		}
+	}
+	return "", false
+}
+
+// FieldValue Get value of patchable field, nil for unknown field
+func (p *Product) FieldValue(field string) interface{} {
+	switch field {
+	case ProductFieldCategoryID:
+		return p.CategoryID
+	case ProductFieldName:
+		return p.Name
+	case ProductFieldDescription:
+		return p.Description
+	case ProductFieldPrice:
+		return p.Price
+	case ProductFieldImageURL:
+		return p.GetImage()
+	case ProductFieldPhotos:
+		return p.Photos
+	case ProductFieldQuantity:
+		return p.Quantity
+	case ProductFieldRating:
+		return p.Rating
+	default:
+		return nil
+	}
+}
+
+// ValidatePatch Validate only given fields with patch rules
+func (p *Product) ValidatePatch(ctx context.Context, validate *validator.Validate, fields []string) error {
+	for _, field := range fields {
+		rules := ProductPatchRules[field]
+		if rules == "" {
+			continue
+		}
+		if err := validate.VarCtx(ctx, p.FieldValue(field), rules); err != nil {
+			return errors.Wrapf(err, "field %s", field)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+)
+
+func TestParseProductMask(t *testing.T) {
+	tests := []struct {
+		name   string
+		paths  []string
+		fields []string
+		err    error
+	}{
+		{
+			name:   "json names",
+			paths:  []string{"name", "price"},
+			fields: []string{ProductFieldName, ProductFieldPrice},
+		},
+		{
+			name:   "proto names are case insensitive",
+			paths:  []string{"CategoryID", "ImageURL", "Quantity"},
+			fields: []string{ProductFieldCategoryID, ProductFieldImageURL, ProductFieldQuantity},
+		},
+		{
+			name:   "repeated paths are written once",
+			paths:  []string{"rating", "Rating", "photos"},
+			fields: []string{ProductFieldRating, ProductFieldPhotos},
+		},
+		{
+			name:  "empty mask",
+			paths: nil,
+			err:   productErrors.ErrInvalidFieldMask,
+		},
+		{
+			name:  "unknown field",
+			paths: []string{"name", "color"},
+			err:   productErrors.ErrInvalidFieldMask,
+		},
+		{
+			name:  "fields maintained by service",
+			paths: []string{"version"},
+			err:   productErrors.ErrInvalidFieldMask,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := ParseProductMask(tt.paths)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("fields = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestProductValidatePatch(t *testing.T) {
+	image := "https://example.com/image.png"
+
+	tests := []struct {
+		name    string
+		product Product
+		fields  []string
+		valid   bool
+	}{
+		{
+			name:    "zero price and quantity",
+			product: Product{},
+			fields:  []string{ProductFieldPrice, ProductFieldQuantity},
+			valid:   true,
+		},
+		{
+			name:    "negative price",
+			product: Product{Price: -1},
+			fields:  []string{ProductFieldPrice},
+		},
+		{
+			name:    "only given fields are validated",
+			product: Product{Price: 10},
+			fields:  []string{ProductFieldPrice},
+			valid:   true,
+		},
+		{
+			name:    "name can not be reset",
+			product: Product{},
+			fields:  []string{ProductFieldName},
+		},
+		{
+			name:    "rating out of range",
+			product: Product{Rating: 11},
+			fields:  []string{ProductFieldRating},
+		},
+		{
+			name:    "fields without rules",
+			product: Product{ImageURL: &image},
+			fields:  []string{ProductFieldImageURL, ProductFieldPhotos, ProductFieldCategoryID},
+			valid:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.product.ValidatePatch(context.Background(), validator.New(), tt.fields)
+			if (err == nil) != tt.valid {
+				t.Errorf("err = %v, want valid: %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestProductFieldValue(t *testing.T) {
+	image := "https://example.com/image.png"
+	prod := &Product{Name: "product", Price: 10, ImageURL: &image, Photos: []string{"photo"}, Quantity: 2, Rating: 5}
+
+	tests := []struct {
+		field string
+		value interface{}
+	}{
+		{field: ProductFieldName, value: "product"},
+		{field: ProductFieldPrice, value: 10.0},
+		{field: ProductFieldImageURL, value: image},
+		{field: ProductFieldPhotos, value: []string{"photo"}},
+		{field: ProductFieldQuantity, value: int64(2)},
+		{field: ProductFieldRating, value: 5},
+		{field: "unknown", value: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if value := prod.FieldValue(tt.field); !reflect.DeepEqual(value, tt.value) {
+				t.Errorf("FieldValue = %#v, want %#v", value, tt.value)
+			}
+		})
+	}
+}
@@ -1,14 +1,33 @@
 package models
 
 import (
+	"strings"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	productsService "github.com/AleksK1NG/products-microservice/proto/product"
 )
 
+const (
+	ProductStatusActive   = "active"
+	ProductStatusArchived = "archived"
+
+	ProductSortRelevance = "relevance"
+)
+
+// ProductSortFields whitelist of search order by fields mapped to document fields
+var ProductSortFields = map[string]string{
+	"price":              "price",
+	"rating":             "rating",
+	"createdAt":          "createdAt",
+	"updatedAt":          "updatedAt",
+	"name":               "name",
+	ProductSortRelevance: "",
+}
+
 // Product models
 type Product struct {
 	ProductID   primitive.ObjectID `json:"productId" bson:"_id,omitempty"`
@@ -22,6 +41,17 @@ type Product struct {
 	Rating      int                `json:"rating,omitempty" bson:"rating,omitempty" validate:"required,min=0,max=10"`
 	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt,omitempty"`
 	UpdatedAt   time.Time          `json:"updatedAt" bson:"updatedAt,omitempty"`
+	Status      string             `json:"status,omitempty" bson:"status,omitempty"`
+	DeletedAt   *time.Time         `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	Score       float64            `json:"score,omitempty" bson:"score,omitempty"`
+	// Version incremented on every write, on update input it is the expected version and 0 skips the check
+	Version int64 `json:"version" bson:"version,omitempty" validate:"gte=0"`
+	// NameLower lower case name used by prefix suggestions, derived from name whenever product is stored
+	NameLower string `json:"-" bson:"nameLower,omitempty"`
+	// WriteToken id of bulk update which wrote product, maintained by repository and removed once results are resolved
+	WriteToken primitive.ObjectID `json:"-" bson:"writeToken,omitempty"`
+	// IdempotencyKey client key of create request, propagated as Kafka header and never stored with product
+	IdempotencyKey string `json:"-" bson:"-" validate:"omitempty,printascii,max=255"`
 }
 
 func (p *Product) GetImage() string {
@@ -32,8 +62,31 @@ func (p *Product) GetImage() string {
 	return img
 }
 
+// GetNameLower Get lower case name used by prefix suggestions
+func (p *Product) GetNameLower() string {
+	return strings.ToLower(p.Name)
+}
+
+// MarshalBSON Encode product with name derived fields, so every write of product document keeps nameLower in sync
+func (p Product) MarshalBSON() ([]byte, error) {
+	type product Product
+	doc := product(p)
+	doc.NameLower = p.GetNameLower()
+	return bson.Marshal(doc)
+}
+
+// IsArchived Check if product is soft deleted
+func (p *Product) IsArchived() bool {
+	return p.Status == ProductStatusArchived
+}
+
 // ToProto Convert product to proto
 func (p *Product) ToProto() *productsService.Product {
+	var deletedAt *timestamppb.Timestamp
+	if p.DeletedAt != nil {
+		deletedAt = timestamppb.New(*p.DeletedAt)
+	}
+
 	return &productsService.Product{
 		ProductID:   p.ProductID.String(),
 		CategoryID:  p.CategoryID.String(),
@@ -46,12 +99,16 @@ func (p *Product) ToProto() *productsService.Product {
 		Rating:      int64(p.Rating),
 		CreatedAt:   timestamppb.New(p.CreatedAt),
 		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		Status:      p.Status,
+		DeletedAt:   deletedAt,
+		Score:       p.Score,
+		Version:     p.Version,
 	}
 }
 
 // ProductFromProto Get Product from proto
 func ProductFromProto(product *productsService.Product) (*Product, error) {
-	prodID, err := primitive.ObjectIDFromHex(product.GetCategoryID())
+	prodID, err := primitive.ObjectIDFromHex(product.GetProductID())
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +117,12 @@ func ProductFromProto(product *productsService.Product) (*Product, error) {
 		return nil, err
 	}
 
+	var deletedAt *time.Time
+	if product.GetDeletedAt() != nil {
+		t := product.GetDeletedAt().AsTime()
+		deletedAt = &t
+	}
+
 	return &Product{
 		ProductID:   prodID,
 		CategoryID:  catID,
@@ -72,17 +135,69 @@ func ProductFromProto(product *productsService.Product) (*Product, error) {
 		Rating:      int(product.GetRating()),
 		CreatedAt:   product.GetCreatedAt().AsTime(),
 		UpdatedAt:   product.GetUpdatedAt().AsTime(),
+		Status:      product.GetStatus(),
+		DeletedAt:   deletedAt,
+	}, nil
+}
+
+// ToEventProto Convert product to Kafka event proto
+func (p *Product) ToEventProto() *productsService.ProductEvent {
+	return &productsService.ProductEvent{
+		ProductID:   p.ProductID.Hex(),
+		CategoryID:  p.CategoryID.Hex(),
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		ImageURL:    p.GetImage(),
+		Photos:      p.Photos,
+		Quantity:    p.Quantity,
+		Rating:      int64(p.Rating),
+		Version:     p.Version,
+	}
+}
+
+// ProductFromEventProto Get Product from Kafka event proto
+func ProductFromEventProto(event *productsService.ProductEvent) (*Product, error) {
+	prodID, err := primitive.ObjectIDFromHex(event.GetProductID())
+	if err != nil {
+		return nil, err
+	}
+	catID, err := primitive.ObjectIDFromHex(event.GetCategoryID())
+	if err != nil {
+		return nil, err
+	}
+
+	var imageURL *string
+	if event.GetImageURL() != "" {
+		img := event.GetImageURL()
+		imageURL = &img
+	}
+
+	return &Product{
+		ProductID:   prodID,
+		CategoryID:  catID,
+		Name:        event.GetName(),
+		Description: event.GetDescription(),
+		Price:       event.GetPrice(),
+		ImageURL:    imageURL,
+		Photos:      event.GetPhotos(),
+		Quantity:    event.GetQuantity(),
+		Rating:      int(event.GetRating()),
+		Version:     event.GetVersion(),
 	}, nil
 }
 
 // ProductsList All Products response with pagination
 type ProductsList struct {
-	TotalCount int64      `json:"totalCount"`
-	TotalPages int64      `json:"totalPages"`
-	Page       int64      `json:"page"`
-	Size       int64      `json:"size"`
-	HasMore    bool       `json:"hasMore"`
-	Products   []*Product `json:"products"`
+	TotalCount int64          `json:"totalCount"`
+	TotalPages int64          `json:"totalPages"`
+	Page       int64          `json:"page"`
+	Size       int64          `json:"size"`
+	HasMore    bool           `json:"hasMore"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	PrevCursor string         `json:"prevCursor,omitempty"`
+	Products   []*Product     `json:"products"`
+	Facets     *ProductFacets `json:"facets,omitempty"`
 }
 
 // ToProtoList convert products list to proto
@@ -93,3 +208,26 @@ func (p *ProductsList) ToProtoList() []*productsService.Product {
 	}
 	return productsList
 }
+
+// ProductsBatch Products of batch get in request order, unknown and archived ids are listed in NotFound
+type ProductsBatch struct {
+	Products []*Product           `json:"products"`
+	NotFound []primitive.ObjectID `json:"notFound"`
+}
+
+// BatchGetRequest Ids of products to get
+type BatchGetRequest struct {
+	ProductIDs []primitive.ObjectID `json:"productIds" validate:"required"`
+}
+
+// ToProto Convert products batch to proto
+func (p *ProductsBatch) ToProto() *productsService.GetByIDsRes {
+	notFound := make([]string, 0, len(p.NotFound))
+	for _, id := range p.NotFound {
+		notFound = append(notFound, id.Hex())
+	}
+	return &productsService.GetByIDsRes{
+		Products: (&ProductsList{Products: p.Products}).ToProtoList(),
+		NotFound: notFound,
+	}
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	deadLetterErrors "github.com/AleksK1NG/products-microservice/pkg/deadletter_errors"
+	deadLettersService "github.com/AleksK1NG/products-microservice/proto/deadletter"
+)
+
+// ReplayMaxReportedMessages max number of messages listed in replay report
+const ReplayMaxReportedMessages = 100
+
+// ReplayFilter selects dead letter messages to replay, empty fields match any message
+type ReplayFilter struct {
+	Topics        []string   `json:"topics"`
+	From          *time.Time `json:"from,omitempty"`
+	To            *time.Time `json:"to,omitempty"`
+	ErrorContains string     `json:"errorContains,omitempty"`
+	Limit         int        `json:"limit,omitempty"`
+	DryRun        bool       `json:"dryRun"`
+}
+
+// Validate Check time range of filter
+func (f *ReplayFilter) Validate() error {
+	if f.From != nil && f.To != nil && f.To.Before(*f.From) {
+		return errors.Wrap(deadLetterErrors.ErrInvalidReplayFilter, "to is before from")
+	}
+	if f.Limit < 0 {
+		return errors.Wrapf(deadLetterErrors.ErrInvalidReplayFilter, "limit: %d", f.Limit)
+	}
+	return nil
+}
+
+// Match Check if dead letter message is selected by filter
+func (f *ReplayFilter) Match(msg *ErrorMessage) bool {
+	if len(f.Topics) > 0 && !containsString(f.Topics, msg.Topic) {
+		return false
+	}
+
+	failedAt := msg.GetFailedAt()
+	if f.From != nil && failedAt.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && failedAt.After(*f.To) {
+		return false
+	}
+
+	return f.ErrorContains == "" || strings.Contains(strings.ToLower(msg.Error), strings.ToLower(f.ErrorContains))
+}
+
+// ReplayedMessage dead letter message selected for replay
+type ReplayedMessage struct {
+	MessageID string    `json:"messageId"`
+	Topic     string    `json:"topic"`
+	Error     string    `json:"error"`
+	FailedAt  time.Time `json:"failedAt"`
+	Replayed  bool      `json:"replayed"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+// ReplayReport progress and result of dead letter replay, at most ReplayMaxReportedMessages messages are listed
+type ReplayReport struct {
+	DryRun   bool               `json:"dryRun"`
+	Scanned  int                `json:"scanned"`
+	Matched  int                `json:"matched"`
+	Replayed int                `json:"replayed"`
+	Skipped  int                `json:"skipped"`
+	Failed   int                `json:"failed"`
+	Messages []*ReplayedMessage `json:"messages"`
+}
+
+// AddMessage Add message to report while list is not full
+func (r *ReplayReport) AddMessage(msg *ReplayedMessage) {
+	if len(r.Messages) < ReplayMaxReportedMessages {
+		r.Messages = append(r.Messages, msg)
+	}
+}
+
+// String Get counters of report
+func (r *ReplayReport) String() string {
+	return fmt.Sprintf(
+		"dryRun: %v, scanned: %d, matched: %d, replayed: %d, skipped: %d, failed: %d",
+		r.DryRun,
+		r.Scanned,
+		r.Matched,
+		r.Replayed,
+		r.Skipped,
+		r.Failed,
+	)
+}
+
+// ReplayFilterFromProto Get replay filter from proto
+func ReplayFilterFromProto(req *deadLettersService.ReplayReq) *ReplayFilter {
+	filter := &ReplayFilter{
+		Topics:        req.GetTopics(),
+		ErrorContains: req.GetErrorContains(),
+		Limit:         int(req.GetLimit()),
+		DryRun:        req.GetDryRun(),
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		filter.To = &to
+	}
+	return filter
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
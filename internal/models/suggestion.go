@@ -0,0 +1,35 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	productsService "github.com/AleksK1NG/products-microservice/proto/product"
+)
+
+const (
+	SuggestDefaultLimit = 10
+	SuggestMaxLimit     = 50
+)
+
+// ProductSuggestion product name matching typed prefix
+type ProductSuggestion struct {
+	ProductID primitive.ObjectID `json:"productId" bson:"_id"`
+	Name      string             `json:"name" bson:"name"`
+}
+
+// ToProto Convert suggestion to proto
+func (s *ProductSuggestion) ToProto() *productsService.ProductSuggestion {
+	return &productsService.ProductSuggestion{
+		ProductID: s.ProductID.Hex(),
+		Name:      s.Name,
+	}
+}
+
+// ProductSuggestionsToProto Convert suggestions to proto
+func ProductSuggestionsToProto(suggestions []*ProductSuggestion) []*productsService.ProductSuggestion {
+	res := make([]*productsService.ProductSuggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		res = append(res, s.ToProto())
+	}
+	return res
+}
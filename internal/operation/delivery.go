@@ -0,0 +1,8 @@
+package operation
+
+import "github.com/labstack/echo/v4"
+
+// HttpDelivery http delivery
+type HttpDelivery interface {
+	GetByIDOperation() echo.HandlerFunc
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	successMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "operations_success_incoming_grpc_messages_total",
+		Help: "The total number of success incoming success gRPC messages",
+	})
+	errorMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "operations_error_incoming_grpc_message_total",
+		Help: "The total number of error incoming success gRPC messages",
+	})
+	getOperationMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "operations_get_operation_incoming_grpc_requests_total",
+		Help: "The total number of incoming get operation gRPC messages",
+	})
+)
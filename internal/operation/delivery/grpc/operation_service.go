@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/AleksK1NG/products-microservice/internal/operation"
+	grpcErrors "github.com/AleksK1NG/products-microservice/pkg/grpc_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	operationsService "github.com/AleksK1NG/products-microservice/proto/operation"
+)
+
+// operationService gRPC Service
+type operationService struct {
+	log         logger.Logger
+	operationUC operation.UseCase
+}
+
+// NewOperationService operationService constructor
+func NewOperationService(log logger.Logger, operationUC operation.UseCase) *operationService {
+	return &operationService{log: log, operationUC: operationUC}
+}
+
+// GetOperation Get status of asynchronous product write
+func (o *operationService) GetOperation(ctx context.Context, req *operationsService.GetOperationReq) (*operationsService.GetOperationRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationService.GetOperation")
+	defer span.Finish()
+	getOperationMessages.Inc()
+
+	op, err := o.operationUC.GetByID(ctx, req.GetOperationID())
+	if err != nil {
+		errorMessages.Inc()
+		o.log.Errorf("operationUC.GetByID: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &operationsService.GetOperationRes{Operation: op.ToProto()}, nil
+}
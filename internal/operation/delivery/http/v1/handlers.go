@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/AleksK1NG/products-microservice/internal/middlewares"
+	"github.com/AleksK1NG/products-microservice/internal/operation"
+	httpErrors "github.com/AleksK1NG/products-microservice/pkg/http_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+)
+
+type operationHandlers struct {
+	log         logger.Logger
+	operationUC operation.UseCase
+	group       *echo.Group
+	mw          middlewares.MiddlewareManager
+}
+
+// NewOperationHandlers constructor
+func NewOperationHandlers(
+	log logger.Logger,
+	operationUC operation.UseCase,
+	group *echo.Group,
+	mw middlewares.MiddlewareManager,
+) *operationHandlers {
+	return &operationHandlers{log: log, operationUC: operationUC, group: group, mw: mw}
+}
+
+// GetByIDOperation Get operation by id
+// @Tags Operations
+// @Summary Get operation by id
+// @Description Get status of asynchronous product create, update or delete
+// @Accept json
+// @Produce json
+// @Param operation_id path string true "operation id"
+// @Success 200 {object} models.Operation
+// @Router /operations/{operation_id} [get]
+func (h *operationHandlers) GetByIDOperation() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "operationHandlers.GetByID")
+		defer span.Finish()
+		getByIdRequests.Inc()
+
+		op, err := h.operationUC.GetByID(ctx, c.Param("operation_id"))
+		if err != nil {
+			h.log.Errorf("operationUC.GetByID: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, op)
+	}
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	successRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_operations_success_incoming_messages_total",
+		Help: "The total number of success incoming success HTTP requests",
+	})
+	errorRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_operations_error_incoming_message_total",
+		Help: "The total number of error incoming success HTTP requests",
+	})
+	getByIdRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_operations_get_by_id_incoming_requests_total",
+		Help: "The total number of incoming get by id operation HTTP requests",
+	})
+)
@@ -0,0 +1,14 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+// RedisRepository Operation
+type RedisRepository interface {
+	SetOperation(ctx context.Context, operation *models.Operation) error
+	GetOperation(ctx context.Context, operationID string) (*models.Operation, error)
+	UpdateOperation(ctx context.Context, operationID string, update func(operation *models.Operation)) error
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	operationErrors "github.com/AleksK1NG/products-microservice/pkg/operation_errors"
+)
+
+const (
+	prefix     = "operations"
+	expiration = time.Hour * 24
+
+	updateAttempts = 10
+)
+
+type operationRedisRepository struct {
+	prefix string
+	redis  *redis.Client
+}
+
+// NewOperationRedisRepository constructor
+func NewOperationRedisRepository(redis *redis.Client) *operationRedisRepository {
+	return &operationRedisRepository{redis: redis, prefix: prefix}
+}
+
+func (o *operationRedisRepository) SetOperation(ctx context.Context, operation *models.Operation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationRedisRepository.SetOperation")
+	defer span.Finish()
+
+	opBytes, err := json.Marshal(operation)
+	if err != nil {
+		return errors.Wrap(err, "operationRedisRepository.Marshal")
+	}
+
+	return o.redis.SetEX(ctx, o.createKey(operation.OperationID), string(opBytes), expiration).Err()
+}
+
+func (o *operationRedisRepository) GetOperation(ctx context.Context, operationID string) (*models.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationRedisRepository.GetOperation")
+	defer span.Finish()
+
+	result, err := o.redis.Get(ctx, o.createKey(operationID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errors.Wrapf(operationErrors.ErrOperationNotFound, "operation id: %s", operationID)
+		}
+		return nil, errors.Wrap(err, "operationRedisRepository.redis.Get")
+	}
+
+	var res models.Operation
+	if err := json.Unmarshal(result, &res); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+	return &res, nil
+}
+
+// UpdateOperation Apply update to stored operation atomically, update is applied again if operation is changed concurrently
+func (o *operationRedisRepository) UpdateOperation(ctx context.Context, operationID string, update func(operation *models.Operation)) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationRedisRepository.UpdateOperation")
+	defer span.Finish()
+
+	key := o.createKey(operationID)
+	for i := 0; i < updateAttempts; i++ {
+		err := o.redis.Watch(ctx, func(tx *redis.Tx) error {
+			result, err := tx.Get(ctx, key).Bytes()
+			if err != nil {
+				if errors.Is(err, redis.Nil) {
+					return errors.Wrapf(operationErrors.ErrOperationNotFound, "operation id: %s", operationID)
+				}
+				return errors.Wrap(err, "operationRedisRepository.redis.Get")
+			}
+
+			var op models.Operation
+			if err := json.Unmarshal(result, &op); err != nil {
+				return errors.Wrap(err, "json.Unmarshal")
+			}
+			update(&op)
+
+			opBytes, err := json.Marshal(&op)
+			if err != nil {
+				return errors.Wrap(err, "operationRedisRepository.Marshal")
+			}
+
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.SetEX(ctx, key, string(opBytes), expiration)
+				return nil
+			})
+			return err
+		}, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		return err
+	}
+
+	return errors.Wrapf(redis.TxFailedErr, "operation id: %s, attempts: %d", operationID, updateAttempts)
+}
+
+func (o *operationRedisRepository) createKey(operationID string) string {
+	return fmt.Sprintf("%s: %s", o.prefix, operationID)
+}
@@ -0,0 +1,18 @@
+package operation
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+// UseCase Operation
+type UseCase interface {
+	Create(ctx context.Context, operationType string, productID primitive.ObjectID) (*models.Operation, error)
+	GetByID(ctx context.Context, operationID string) (*models.Operation, error)
+	SetSucceeded(ctx context.Context, operationID string) error
+	SetFailed(ctx context.Context, operationID string, reason error) error
+	SetResult(ctx context.Context, operationID string, result interface{}) error
+}
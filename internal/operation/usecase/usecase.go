@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/internal/operation"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+)
+
+// operationUC
+type operationUC struct {
+	redisRepo operation.RedisRepository
+	log       logger.Logger
+}
+
+// NewOperationUC constructor
+func NewOperationUC(redisRepo operation.RedisRepository, log logger.Logger) *operationUC {
+	return &operationUC{redisRepo: redisRepo, log: log}
+}
+
+// Create Create pending operation of product write
+func (o *operationUC) Create(ctx context.Context, operationType string, productID primitive.ObjectID) (*models.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationUC.Create")
+	defer span.Finish()
+
+	now := time.Now().UTC()
+	op := &models.Operation{
+		OperationID: primitive.NewObjectID().Hex(),
+		Type:        operationType,
+		ProductID:   productID,
+		Status:      models.OperationStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := o.redisRepo.SetOperation(ctx, op); err != nil {
+		return nil, errors.Wrap(err, "redisRepo.SetOperation")
+	}
+
+	return op, nil
+}
+
+// GetByID Get operation by id
+func (o *operationUC) GetByID(ctx context.Context, operationID string) (*models.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationUC.GetByID")
+	defer span.Finish()
+
+	return o.redisRepo.GetOperation(ctx, operationID)
+}
+
+// SetSucceeded Mark operation as succeeded
+func (o *operationUC) SetSucceeded(ctx context.Context, operationID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationUC.SetSucceeded")
+	defer span.Finish()
+
+	return o.setStatus(ctx, operationID, models.OperationStatusSucceeded, "")
+}
+
+// SetFailed Mark operation as failed with reason
+func (o *operationUC) SetFailed(ctx context.Context, operationID string, reason error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationUC.SetFailed")
+	defer span.Finish()
+
+	return o.setStatus(ctx, operationID, models.OperationStatusFailed, reason.Error())
+}
+
+// SetResult Set progress or outcome of operation, status is not changed
+func (o *operationUC) SetResult(ctx context.Context, operationID string, result interface{}) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "operationUC.SetResult")
+	defer span.Finish()
+
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	if err := o.redisRepo.UpdateOperation(ctx, operationID, func(op *models.Operation) {
+		op.Result = resultBytes
+		op.UpdatedAt = time.Now().UTC()
+	}); err != nil {
+		return errors.Wrap(err, "redisRepo.UpdateOperation")
+	}
+
+	return nil
+}
+
+func (o *operationUC) setStatus(ctx context.Context, operationID string, status string, reason string) error {
+	if err := o.redisRepo.UpdateOperation(ctx, operationID, func(op *models.Operation) {
+		op.Status = status
+		op.Error = reason
+		op.UpdatedAt = time.Now().UTC()
+	}); err != nil {
+		return errors.Wrap(err, "redisRepo.UpdateOperation")
+	}
+
+	return nil
+}
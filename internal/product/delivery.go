@@ -6,6 +6,15 @@ import "github.com/labstack/echo/v4"
 type HttpDelivery interface {
 	CreateProduct() echo.HandlerFunc
 	UpdateProduct() echo.HandlerFunc
+	PatchProduct() echo.HandlerFunc
+	UpsertProduct() echo.HandlerFunc
+	BulkCreateProducts() echo.HandlerFunc
+	BulkUpdateProducts() echo.HandlerFunc
 	GetByIDProduct() echo.HandlerFunc
+	BatchGetProducts() echo.HandlerFunc
 	SearchProduct() echo.HandlerFunc
+	SuggestProduct() echo.HandlerFunc
+	DeleteProduct() echo.HandlerFunc
+	ArchiveProduct() echo.HandlerFunc
+	RestoreProduct() echo.HandlerFunc
 }
@@ -22,12 +22,44 @@ var (
 		Name: "products_update_incoming_grpc_requests_total",
 		Help: "The total number of incoming update product gRPC messages",
 	})
+	upsertMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_upsert_incoming_grpc_requests_total",
+		Help: "The total number of incoming upsert product gRPC messages",
+	})
 	getByIdMessages = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "products_get_by_id_incoming_grpc_requests_total",
 		Help: "The total number of incoming get by id product gRPC messages",
 	})
+	getByIdsMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_get_by_ids_incoming_grpc_requests_total",
+		Help: "The total number of incoming get by ids products gRPC messages",
+	})
 	searchMessages = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "products_search_incoming_grpc_requests_total",
 		Help: "The total number of incoming search products gRPC messages",
 	})
+	listByCategoryMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_list_by_category_incoming_grpc_requests_total",
+		Help: "The total number of incoming list products by category gRPC messages",
+	})
+	suggestMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_suggest_incoming_grpc_requests_total",
+		Help: "The total number of incoming suggest products gRPC messages",
+	})
+	deleteMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_delete_incoming_grpc_requests_total",
+		Help: "The total number of incoming delete product gRPC messages",
+	})
+	archiveMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_archive_incoming_grpc_requests_total",
+		Help: "The total number of incoming archive product gRPC messages",
+	})
+	restoreMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_restore_incoming_grpc_requests_total",
+		Help: "The total number of incoming restore product gRPC messages",
+	})
+	bulkCreateMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "products_bulk_create_incoming_grpc_requests_total",
+		Help: "The total number of incoming bulk create products gRPC streams",
+	})
 )
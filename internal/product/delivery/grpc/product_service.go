@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"io"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/AleksK1NG/products-microservice/internal/models"
@@ -40,15 +42,22 @@ func (p *productService) Create(ctx context.Context, req *productsService.Create
 		return nil, grpcErrors.ErrorResponse(err, err.Error())
 	}
 
+	if err := p.validate.VarCtx(ctx, req.GetIdempotencyKey(), models.IdempotencyKeyRules); err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("validate.VarCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
 	prod := &models.Product{
-		CategoryID:  catID,
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Price:       req.GetPrice(),
-		ImageURL:    &req.ImageURL,
-		Photos:      req.GetPhotos(),
-		Quantity:    req.GetQuantity(),
-		Rating:      int(req.GetRating()),
+		CategoryID:     catID,
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		Price:          req.GetPrice(),
+		ImageURL:       &req.ImageURL,
+		Photos:         req.GetPhotos(),
+		Quantity:       req.GetQuantity(),
+		Rating:         int(req.GetRating()),
+		IdempotencyKey: req.GetIdempotencyKey(),
 	}
 
 	created, err := p.productUC.Create(ctx, prod)
@@ -62,7 +71,95 @@ func (p *productService) Create(ctx context.Context, req *productsService.Create
 	return &productsService.CreateRes{Product: created.ToProto()}, nil
 }
 
-// Update Update existing product
+// BulkCreate Create products received from client stream, products are written in chunks of models.BulkMaxItems
+// and invalid products are reported in result without stopping the rest
+func (p *productService) BulkCreate(stream productsService.ProductsService_BulkCreateServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "productService.BulkCreate")
+	defer span.Finish()
+	bulkCreateMessages.Inc()
+
+	result := models.NewBulkResult(0)
+	chunk := make([]*productsService.CreateReq, 0, models.BulkMaxItems)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errorMessages.Inc()
+			p.log.Errorf("stream.Recv: %v", err)
+			return grpcErrors.ErrorResponse(err, err.Error())
+		}
+
+		chunk = append(chunk, req)
+		if len(chunk) < models.BulkMaxItems {
+			continue
+		}
+		if err := p.bulkCreate(ctx, chunk, result); err != nil {
+			errorMessages.Inc()
+			p.log.Errorf("productService.bulkCreate: %v", err)
+			return grpcErrors.ErrorResponse(err, err.Error())
+		}
+		chunk = chunk[:0]
+	}
+
+	if len(chunk) > 0 {
+		if err := p.bulkCreate(ctx, chunk, result); err != nil {
+			errorMessages.Inc()
+			p.log.Errorf("productService.bulkCreate: %v", err)
+			return grpcErrors.ErrorResponse(err, err.Error())
+		}
+	}
+
+	successMessages.Inc()
+	return stream.SendAndClose(result.ToProto())
+}
+
+// bulkCreate Create valid products of chunk and append results of whole chunk to result
+func (p *productService) bulkCreate(ctx context.Context, reqs []*productsService.CreateReq, result *models.BulkResult) error {
+	chunk := models.NewBulkResult(len(reqs))
+	products := make([]*models.Product, 0, len(reqs))
+	indexes := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		catID, err := primitive.ObjectIDFromHex(req.GetCategoryID())
+		if err != nil {
+			chunk.SetFailed(i, primitive.NilObjectID, err)
+			continue
+		}
+
+		prod := &models.Product{
+			CategoryID:     catID,
+			Name:           req.GetName(),
+			Description:    req.GetDescription(),
+			Price:          req.GetPrice(),
+			ImageURL:       &req.ImageURL,
+			Photos:         req.GetPhotos(),
+			Quantity:       req.GetQuantity(),
+			Rating:         int(req.GetRating()),
+			IdempotencyKey: req.GetIdempotencyKey(),
+		}
+		if err := p.validate.StructCtx(ctx, prod); err != nil {
+			chunk.SetFailed(i, primitive.NilObjectID, err)
+			continue
+		}
+
+		products = append(products, prod)
+		indexes = append(indexes, i)
+	}
+
+	if len(products) > 0 {
+		created, err := p.productUC.BulkCreate(ctx, products)
+		if err != nil {
+			return err
+		}
+		chunk.Merge(indexes, created)
+	}
+
+	result.Append(chunk)
+	return nil
+}
+
+// Update Update existing product, only fields of UpdateMask are written when it is set
 func (p *productService) Update(ctx context.Context, req *productsService.UpdateReq) (*productsService.UpdateRes, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Update")
 	defer span.Finish()
@@ -74,6 +171,19 @@ func (p *productService) Update(ctx context.Context, req *productsService.Update
 		p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
 		return nil, grpcErrors.ErrorResponse(err, err.Error())
 	}
+
+	if req.GetUpdateMask() != nil {
+		patched, err := p.patch(ctx, prodID, req)
+		if err != nil {
+			errorMessages.Inc()
+			p.log.Errorf("productService.patch: %v", err)
+			return nil, grpcErrors.ErrorResponse(err, err.Error())
+		}
+
+		successMessages.Inc()
+		return &productsService.UpdateRes{Product: patched.ToProto()}, nil
+	}
+
 	catID, err := primitive.ObjectIDFromHex(req.GetCategoryID())
 	if err != nil {
 		errorMessages.Inc()
@@ -91,6 +201,7 @@ func (p *productService) Update(ctx context.Context, req *productsService.Update
 		Photos:      req.GetPhotos(),
 		Quantity:    req.GetQuantity(),
 		Rating:      int(req.GetRating()),
+		Version:     req.GetExpectedVersion(),
 	}
 
 	update, err := p.productUC.Update(ctx, prod)
@@ -104,6 +215,94 @@ func (p *productService) Update(ctx context.Context, req *productsService.Update
 	return &productsService.UpdateRes{Product: update.ToProto()}, nil
 }
 
+// Upsert Create product with given id or replace all its fields
+func (p *productService) Upsert(ctx context.Context, req *productsService.UpsertReq) (*productsService.UpsertRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Upsert")
+	defer span.Finish()
+	upsertMessages.Inc()
+
+	prodID, err := primitive.ObjectIDFromHex(req.GetProductID())
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	var catID primitive.ObjectID
+	if req.GetCategoryID() != "" {
+		if catID, err = primitive.ObjectIDFromHex(req.GetCategoryID()); err != nil {
+			errorMessages.Inc()
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			return nil, grpcErrors.ErrorResponse(err, err.Error())
+		}
+	}
+
+	prod := &models.Product{
+		ProductID:   prodID,
+		CategoryID:  catID,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+		ImageURL:    &req.ImageURL,
+		Photos:      req.GetPhotos(),
+		Quantity:    req.GetQuantity(),
+		Rating:      int(req.GetRating()),
+	}
+
+	if err := p.validate.StructCtx(ctx, prod); err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("validate.StructCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	upserted, created, err := p.productUC.Upsert(ctx, prod)
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.Upsert: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &productsService.UpsertRes{Product: upserted.ToProto(), Created: created}, nil
+}
+
+// patch Update fields of UpdateMask only, empty category id removes product category
+func (p *productService) patch(ctx context.Context, productID primitive.ObjectID, req *productsService.UpdateReq) (*models.Product, error) {
+	fields, err := models.ParseProductMask(req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	var catID primitive.ObjectID
+	if req.GetCategoryID() != "" {
+		if catID, err = primitive.ObjectIDFromHex(req.GetCategoryID()); err != nil {
+			return nil, err
+		}
+	}
+
+	prod := &models.Product{
+		ProductID:   productID,
+		CategoryID:  catID,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+		ImageURL:    &req.ImageURL,
+		Photos:      req.GetPhotos(),
+		Quantity:    req.GetQuantity(),
+		Rating:      int(req.GetRating()),
+		Version:     req.GetExpectedVersion(),
+	}
+
+	if err := prod.ValidatePatch(ctx, p.validate, fields); err != nil {
+		return nil, err
+	}
+	if err := p.validate.VarCtx(ctx, prod.Version, "gte=0"); err != nil {
+		return nil, errors.Wrap(err, "expected version")
+	}
+
+	return p.productUC.Patch(ctx, prod, fields)
+}
+
 // GetByID Get single product by id
 func (p *productService) GetByID(ctx context.Context, req *productsService.GetByIDReq) (*productsService.GetByIDRes, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.GetByID")
@@ -128,13 +327,58 @@ func (p *productService) GetByID(ctx context.Context, req *productsService.GetBy
 	return &productsService.GetByIDRes{Product: prod.ToProto()}, nil
 }
 
+// GetByIDs Get products by ids in request order
+func (p *productService) GetByIDs(ctx context.Context, req *productsService.GetByIDsReq) (*productsService.GetByIDsRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.GetByIDs")
+	defer span.Finish()
+	getByIdsMessages.Inc()
+
+	productIDs := make([]primitive.ObjectID, 0, len(req.GetProductIDs()))
+	for _, id := range req.GetProductIDs() {
+		prodID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			errorMessages.Inc()
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			return nil, grpcErrors.ErrorResponse(err, err.Error())
+		}
+		productIDs = append(productIDs, prodID)
+	}
+
+	batch, err := p.productUC.GetByIDs(ctx, productIDs)
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.GetByIDs: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return batch.ToProto(), nil
+}
+
 // Search Search products
 func (p *productService) Search(ctx context.Context, req *productsService.SearchReq) (*productsService.SearchRes, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Search")
 	defer span.Finish()
 	searchMessages.Inc()
 
-	products, err := p.productUC.Search(ctx, req.GetSearch(), utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	filter, err := models.ProductsFilterFromProto(
+		req.GetFilter(),
+		req.GetIncludeArchived(),
+		req.GetLanguage(),
+		req.GetFacets(),
+	)
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("models.ProductsFilterFromProto: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	pq := utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	pq.SetOrderBy(req.GetOrderBy())
+	pq.SetCursor(req.GetCursor())
+	pq.SkipCount = req.GetSkipCount()
+
+	products, err := p.productUC.Search(ctx, req.GetSearch(), filter, pq)
 	if err != nil {
 		errorMessages.Inc()
 		p.log.Errorf("productUC.Search: %v", err)
@@ -149,5 +393,133 @@ func (p *productService) Search(ctx context.Context, req *productsService.Search
 		Size:       products.Size,
 		HasMore:    products.HasMore,
 		Products:   products.ToProtoList(),
+		NextCursor: products.NextCursor,
+		PrevCursor: products.PrevCursor,
+		Facets:     products.Facets.ToProto(),
+	}, nil
+}
+
+// ListByCategory List products of category
+func (p *productService) ListByCategory(ctx context.Context, req *productsService.ListByCategoryReq) (*productsService.ListByCategoryRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.ListByCategory")
+	defer span.Finish()
+	listByCategoryMessages.Inc()
+
+	catID, err := primitive.ObjectIDFromHex(req.GetCategoryID())
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	pq := utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	pq.SetCursor(req.GetCursor())
+	pq.SkipCount = req.GetSkipCount()
+
+	products, err := p.productUC.ListByCategory(ctx, catID, req.GetIncludeDescendants(), pq)
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.ListByCategory: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &productsService.ListByCategoryRes{
+		TotalCount: products.TotalCount,
+		TotalPages: products.TotalPages,
+		Page:       products.Page,
+		Size:       products.Size,
+		HasMore:    products.HasMore,
+		Products:   products.ToProtoList(),
+		NextCursor: products.NextCursor,
+		PrevCursor: products.PrevCursor,
 	}, nil
 }
+
+// Suggest Suggest product names by prefix
+func (p *productService) Suggest(ctx context.Context, req *productsService.SuggestReq) (*productsService.SuggestRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Suggest")
+	defer span.Finish()
+	suggestMessages.Inc()
+
+	suggestions, err := p.productUC.Suggest(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.Suggest: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &productsService.SuggestRes{Suggestions: models.ProductSuggestionsToProto(suggestions)}, nil
+}
+
+// Delete Delete single product by id
+func (p *productService) Delete(ctx context.Context, req *productsService.DeleteReq) (*productsService.DeleteRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Delete")
+	defer span.Finish()
+	deleteMessages.Inc()
+
+	prodID, err := primitive.ObjectIDFromHex(req.GetProductID())
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := p.productUC.Delete(ctx, prodID); err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.Delete: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &productsService.DeleteRes{}, nil
+}
+
+// Archive Archive single product by id
+func (p *productService) Archive(ctx context.Context, req *productsService.ArchiveReq) (*productsService.ArchiveRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Archive")
+	defer span.Finish()
+	archiveMessages.Inc()
+
+	prodID, err := primitive.ObjectIDFromHex(req.GetProductID())
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	prod, err := p.productUC.Archive(ctx, prodID)
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.Archive: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &productsService.ArchiveRes{Product: prod.ToProto()}, nil
+}
+
+// Restore Restore single archived product by id
+func (p *productService) Restore(ctx context.Context, req *productsService.RestoreReq) (*productsService.RestoreRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productService.Restore")
+	defer span.Finish()
+	restoreMessages.Inc()
+
+	prodID, err := primitive.ObjectIDFromHex(req.GetProductID())
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	prod, err := p.productUC.Restore(ctx, prodID)
+	if err != nil {
+		errorMessages.Inc()
+		p.log.Errorf("productUC.Restore: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	successMessages.Inc()
+	return &productsService.RestoreRes{Product: prod.ToProto()}, nil
+}
@@ -1,14 +1,23 @@
 package v1
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"path"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/AleksK1NG/products-microservice/config"
 	"github.com/AleksK1NG/products-microservice/internal/middlewares"
 	"github.com/AleksK1NG/products-microservice/internal/models"
 	"github.com/AleksK1NG/products-microservice/internal/product"
@@ -18,8 +27,24 @@ import (
 	"github.com/AleksK1NG/products-microservice/pkg/utils"
 )
 
+const (
+	writeModeHeader     = "X-Write-Mode"
+	writeModeQueryParam = "writeMode"
+	writeModeAsync      = "async"
+	writeModeSync       = "sync"
+
+	headerETag            = "ETag"
+	headerIfMatch         = "If-Match"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+	headerLastModified    = "Last-Modified"
+	headerCacheControl    = "Cache-Control"
+	headerIdempotencyKey  = "Idempotency-Key"
+)
+
 type productHandlers struct {
 	log       logger.Logger
+	cfg       *config.Config
 	productUC product.UseCase
 	validate  *validator.Validate
 	group     *echo.Group
@@ -29,21 +54,27 @@ type productHandlers struct {
 // NewProductHandlers constructor
 func NewProductHandlers(
 	log logger.Logger,
+	cfg *config.Config,
 	productUC product.UseCase,
 	validate *validator.Validate,
 	group *echo.Group,
 	mw middlewares.MiddlewareManager,
 ) *productHandlers {
-	return &productHandlers{log: log, productUC: productUC, validate: validate, group: group, mw: mw}
+	return &productHandlers{log: log, cfg: cfg, productUC: productUC, validate: validate, group: group, mw: mw}
 }
 
 // CreateProduct Create product
 // @Tags Products
 // @Summary Create new product
-// @Description Create new single product
+// @Description Create new single product, asynchronously by default, sync write mode returns stored product.
+// @Description Repeated request with the same Idempotency-Key returns result of the first one, the key reused with different product is rejected
 // @Accept json
 // @Produce json
-// @Success 201 {object} models.Product
+// @Param Idempotency-Key header string false "client generated unique request key, kept for 24 hours"
+// @Param X-Write-Mode header string false "write mode: async or sync"
+// @Param writeMode query string false "write mode: async or sync, overrides header"
+// @Success 201 {object} models.Operation "operation in async mode, models.Product in sync mode"
+// @Header 201 {string} Location "url of product"
 // @Router /products [post]
 func (p *productHandlers) CreateProduct() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -56,30 +87,59 @@ func (p *productHandlers) CreateProduct() echo.HandlerFunc {
 			p.log.Errorf("c.Bind: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
+		prod.IdempotencyKey = c.Request().Header.Get(headerIdempotencyKey)
 
 		if err := p.validate.StructCtx(ctx, &prod); err != nil {
 			p.log.Errorf("validate.StructCtx: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		if err := p.productUC.PublishCreate(ctx, &prod); err != nil {
+		writeMode, err := p.getWriteMode(c)
+		if err != nil {
+			p.log.Errorf("getWriteMode: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.NewBadRequestError(err.Error()))
+		}
+
+		if writeMode == writeModeSync {
+			prod.ProductID = primitive.NilObjectID
+			created, err := p.productUC.Create(ctx, &prod)
+			if err != nil {
+				p.log.Errorf("productUC.Create: %v", err)
+				errorRequests.Inc()
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+
+			successRequests.Inc()
+			setETag(c, created)
+			c.Response().Header().Set(echo.HeaderLocation, path.Join(c.Request().URL.Path, created.ProductID.Hex()))
+			return c.JSON(http.StatusCreated, created)
+		}
+
+		op, err := p.productUC.PublishCreate(ctx, &prod)
+		if err != nil {
 			p.log.Errorf("productUC.PublishCreate: %v", err)
+			errorRequests.Inc()
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
 		successRequests.Inc()
-		return c.NoContent(http.StatusCreated)
+		c.Response().Header().Set(echo.HeaderLocation, path.Join(c.Request().URL.Path, op.ProductID.Hex()))
+		return c.JSON(http.StatusCreated, op)
 	}
 }
 
 // UpdateProduct Update product
 // @Tags Products
 // @Summary Update single product
-// @Description Update single product by id
+// @Description Update single product by id, mismatching If-Match or body version returns 409 in both write modes
 // @Accept json
 // @Produce json
 // @Param product_id path string true "product id"
-// @Success 200 {object} models.Product
+// @Param If-Match header string false "expected product ETag, overrides body version"
+// @Param X-Write-Mode header string false "write mode: async or sync"
+// @Param writeMode query string false "write mode: async or sync, overrides header"
+// @Success 200 {object} models.Operation "operation in async mode, models.Product in sync mode"
 // @Router /products/{product_id} [put]
 func (p *productHandlers) UpdateProduct() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -102,30 +162,204 @@ func (p *productHandlers) UpdateProduct() echo.HandlerFunc {
 		}
 		prod.ProductID = prodID
 
+		expectedVersion, err := getIfMatchVersion(c)
+		if err != nil {
+			p.log.Errorf("getIfMatchVersion: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.NewBadRequestError(err.Error()))
+		}
+		if expectedVersion > 0 {
+			prod.Version = expectedVersion
+		}
+
 		if err := p.validate.StructCtx(ctx, &prod); err != nil {
 			p.log.Errorf("validate.StructCtx: %v", err)
 			errorRequests.Inc()
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		if err := p.productUC.PublishUpdate(ctx, &prod); err != nil {
+		writeMode, err := p.getWriteMode(c)
+		if err != nil {
+			p.log.Errorf("getWriteMode: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.NewBadRequestError(err.Error()))
+		}
+
+		if writeMode == writeModeSync {
+			updated, err := p.productUC.Update(ctx, &prod)
+			if err != nil {
+				p.log.Errorf("productUC.Update: %v", err)
+				errorRequests.Inc()
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+
+			successRequests.Inc()
+			setETag(c, updated)
+			return c.JSON(http.StatusOK, updated)
+		}
+
+		op, err := p.productUC.PublishUpdate(ctx, &prod)
+		if err != nil {
 			p.log.Errorf("productUC.PublishUpdate: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, op)
+	}
+}
+
+// UpsertProduct Create or replace product
+// @Tags Products
+// @Summary Create or replace single product
+// @Description Create product with given id or replace all its fields, upsert is applied synchronously, archived product is not found
+// @Accept json
+// @Produce json
+// @Param product_id path string true "product id"
+// @Success 200 {object} models.Product
+// @Success 201 {object} models.Product
+// @Router /products/{product_id}/upsert [put]
+func (p *productHandlers) UpsertProduct() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.Upsert")
+		defer span.Finish()
+		upsertRequests.Inc()
+
+		var prod models.Product
+		if err := c.Bind(&prod); err != nil {
+			p.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		prodID, err := primitive.ObjectIDFromHex(c.Param("product_id"))
+		if err != nil {
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+		prod.ProductID = prodID
+
+		if err := p.validate.StructCtx(ctx, &prod); err != nil {
+			p.log.Errorf("validate.StructCtx: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		upserted, created, err := p.productUC.Upsert(ctx, &prod)
+		if err != nil {
+			p.log.Errorf("productUC.Upsert: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		setETag(c, upserted)
+		if created {
+			c.Response().Header().Set(echo.HeaderLocation, path.Dir(c.Request().URL.Path))
+			return c.JSON(http.StatusCreated, upserted)
+		}
+		return c.JSON(http.StatusOK, upserted)
+	}
+}
+
+// PatchProduct Partially update product
+// @Tags Products
+// @Summary Partially update single product
+// @Description Update only fields present in JSON merge patch body, null resets field, patch is applied synchronously, mismatching If-Match returns 409
+// @Accept json
+// @Produce json
+// @Param product_id path string true "product id"
+// @Param If-Match header string false "expected product ETag"
+// @Success 200 {object} models.Product
+// @Router /products/{product_id} [patch]
+func (p *productHandlers) PatchProduct() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.Patch")
+		defer span.Finish()
+		patchRequests.Inc()
+
+		prodID, err := primitive.ObjectIDFromHex(c.Param("product_id"))
+		if err != nil {
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		body, err := ioutil.ReadAll(c.Request().Body)
+		if err != nil {
+			p.log.Errorf("ioutil.ReadAll: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+
+		// merge patch keys are the field mask, values are decoded into product
+		var patch map[string]json.RawMessage
+		if err := json.Unmarshal(body, &patch); err != nil {
+			p.log.Errorf("json.Unmarshal: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+		paths := make([]string, 0, len(patch))
+		for field := range patch {
+			paths = append(paths, field)
+		}
+
+		fields, err := models.ParseProductMask(paths)
+		if err != nil {
+			p.log.Errorf("models.ParseProductMask: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var prod models.Product
+		if err := json.Unmarshal(body, &prod); err != nil {
+			p.log.Errorf("json.Unmarshal: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+		prod.ProductID = prodID
+
+		if prod.Version, err = getIfMatchVersion(c); err != nil {
+			p.log.Errorf("getIfMatchVersion: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.NewBadRequestError(err.Error()))
+		}
+
+		if err := prod.ValidatePatch(ctx, p.validate, fields); err != nil {
+			p.log.Errorf("prod.ValidatePatch: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		patched, err := p.productUC.Patch(ctx, &prod, fields)
+		if err != nil {
+			p.log.Errorf("productUC.Patch: %v", err)
+			errorRequests.Inc()
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
 		successRequests.Inc()
-		return c.NoContent(http.StatusOK)
+		setETag(c, patched)
+		return c.JSON(http.StatusOK, patched)
 	}
 }
 
 // GetByIDProduct Get product by id
 // @Tags Products
 // @Summary Get product by id
-// @Description Get single product by id
+// @Description Get single product by id, If-None-Match or If-Modified-Since matching current product return 304 without body
 // @Accept json
 // @Produce json
 // @Param product_id path string true "product id"
+// @Param If-None-Match header string false "ETag of cached product"
+// @Param If-Modified-Since header string false "Last-Modified of cached product"
 // @Success 200 {object} models.Product
+// @Success 304 {string} string "product not modified"
+// @Header 200 {string} ETag "product version"
+// @Header 200 {string} Last-Modified "product update time"
+// @Header 200 {string} Cache-Control "revalidation policy"
 // @Router /products/{product_id} [get]
 func (p *productHandlers) GetByIDProduct() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -148,19 +382,79 @@ func (p *productHandlers) GetByIDProduct() echo.HandlerFunc {
 		}
 
 		successRequests.Inc()
+		p.setCacheHeaders(c, prod)
+		if isNotModified(c, prod) {
+			notModifiedRequests.Inc()
+			return c.NoContent(http.StatusNotModified)
+		}
 		return c.JSON(http.StatusOK, prod)
 	}
 }
 
+// BatchGetProducts Get products by ids
+// @Tags Products
+// @Summary Get many products by ids
+// @Description Get up to 1000 products in request order, unknown or archived ids are returned in notFound
+// @Accept json
+// @Produce json
+// @Param request body models.BatchGetRequest true "product ids"
+// @Success 200 {object} models.ProductsBatch
+// @Router /products/batch-get [post]
+func (p *productHandlers) BatchGetProducts() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.BatchGet")
+		defer span.Finish()
+		getByIdsRequests.Inc()
+
+		var req models.BatchGetRequest
+		if err := c.Bind(&req); err != nil {
+			p.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := p.validate.StructCtx(ctx, &req); err != nil {
+			p.log.Errorf("validate.StructCtx: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		batch, err := p.productUC.GetByIDs(ctx, req.ProductIDs)
+		if err != nil {
+			p.log.Errorf("productUC.GetByIDs: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, batch)
+	}
+}
+
 // SearchProduct Search product
 // @Tags Products
 // @Summary Search product
-// @Description Search product by name or description
+// @Description Full text search product by name or description, results are ranked by relevance unless orderBy is set
 // @Accept json
 // @Produce json
 // @Param search query string false "search text"
 // @Param page query string false "page number"
 // @Param size query string false "number of elements"
+// @Param language query string false "text search language, e.g. english, russian or none"
+// @Param includeArchived query bool false "include archived products"
+// @Param minPrice query number false "minimum price"
+// @Param maxPrice query number false "maximum price"
+// @Param minRating query int false "minimum rating"
+// @Param inStock query bool false "only products with positive quantity"
+// @Param categoryIds query string false "comma separated category ids"
+// @Param createdFrom query string false "created at lower bound, RFC3339"
+// @Param createdTo query string false "created at upper bound, RFC3339"
+// @Param updatedFrom query string false "updated at lower bound, RFC3339"
+// @Param updatedTo query string false "updated at upper bound, RFC3339"
+// @Param orderBy query string false "comma separated sort fields with optional direction: price, rating, createdAt, updatedAt, name, relevance, e.g. price:desc,name"
+// @Param cursor query string false "nextCursor or prevCursor of previous response, page is ignored when set"
+// @Param skipCount query bool false "skip total count calculation"
+// @Param facets query string false "comma separated facet counts to return: category, price, rating"
 // @Success 200 {object} models.ProductsList
 // @Router /products/search [get]
 func (p *productHandlers) SearchProduct() echo.HandlerFunc {
@@ -169,21 +463,33 @@ func (p *productHandlers) SearchProduct() echo.HandlerFunc {
 		defer span.Finish()
 		searchRequests.Inc()
 
-		page, err := strconv.Atoi(c.QueryParam("page"))
-		if err != nil {
-			p.log.Errorf("strconv.Atoi: %v", err)
+		pq := &utils.Pagination{}
+		if err := pq.SetSize(c.QueryParam("size")); err != nil {
+			p.log.Errorf("pq.SetSize: %v", err)
 			errorRequests.Inc()
 			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
 		}
-		size, err := strconv.Atoi(c.QueryParam("size"))
-		if err != nil {
-			p.log.Errorf("strconv.Atoi: %v", err)
+		if err := pq.SetPage(c.QueryParam("page")); err != nil {
+			p.log.Errorf("pq.SetPage: %v", err)
 			errorRequests.Inc()
 			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
 		}
+		if err := pq.SetSkipCount(c.QueryParam("skipCount")); err != nil {
+			p.log.Errorf("pq.SetSkipCount: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+		pq.SetOrderBy(c.QueryParam("orderBy"))
+		pq.SetCursor(c.QueryParam("cursor"))
+
+		filter, err := getProductsFilter(c)
+		if err != nil {
+			p.log.Errorf("getProductsFilter: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, httpErrors.NewBadRequestError(err.Error()))
+		}
 
-		pq := utils.NewPaginationQuery(size, page)
-		result, err := p.productUC.Search(ctx, c.QueryParam("search"), pq)
+		result, err := p.productUC.Search(ctx, c.QueryParam("search"), filter, pq)
 		if err != nil {
 			p.log.Errorf("productUC.Search: %v", err)
 			errorRequests.Inc()
@@ -194,3 +500,438 @@ func (p *productHandlers) SearchProduct() echo.HandlerFunc {
 		return c.JSON(http.StatusOK, result)
 	}
 }
+
+// BulkCreateProducts Create products
+// @Tags Products
+// @Summary Create many products
+// @Description Create up to 1000 products synchronously, every product is reported in result instead of failing the whole request
+// @Accept json
+// @Produce json
+// @Param products body []models.Product true "products"
+// @Success 200 {object} models.BulkResult
+// @Router /products/bulk [post]
+func (p *productHandlers) BulkCreateProducts() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.BulkCreate")
+		defer span.Finish()
+		bulkCreateRequests.Inc()
+
+		var products []*models.Product
+		if err := c.Bind(&products); err != nil {
+			p.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+		for _, prod := range products {
+			prod.ProductID = primitive.NilObjectID
+		}
+
+		result, err := p.bulkWrite(ctx, products, p.productUC.BulkCreate)
+		if err != nil {
+			p.log.Errorf("productUC.BulkCreate: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// BulkUpdateProducts Update products
+// @Tags Products
+// @Summary Update many products
+// @Description Update up to 1000 products by productId synchronously, products with version are updated only if it matches,
+// @Description every product is reported in result instead of failing the whole request, products without productId
+// @Description or with repeated productId are failed
+// @Accept json
+// @Produce json
+// @Param products body []models.Product true "products"
+// @Success 200 {object} models.BulkResult
+// @Router /products/bulk [put]
+func (p *productHandlers) BulkUpdateProducts() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.BulkUpdate")
+		defer span.Finish()
+		bulkUpdateRequests.Inc()
+
+		var products []*models.Product
+		if err := c.Bind(&products); err != nil {
+			p.log.Errorf("c.Bind: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		result, err := p.bulkWrite(ctx, products, p.productUC.BulkUpdate)
+		if err != nil {
+			p.log.Errorf("productUC.BulkUpdate: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// SuggestProduct Suggest product names
+// @Tags Products
+// @Summary Suggest product names
+// @Description Get names of products starting with query for typeahead
+// @Accept json
+// @Produce json
+// @Param q query string true "name prefix"
+// @Param limit query int false "max number of suggestions, 10 by default, at most 50"
+// @Success 200 {array} models.ProductSuggestion
+// @Router /products/suggest [get]
+func (p *productHandlers) SuggestProduct() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.Suggest")
+		defer span.Finish()
+		suggestRequests.Inc()
+
+		var limit int
+		if v := c.QueryParam("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				p.log.Errorf("strconv.Atoi: %v", err)
+				errorRequests.Inc()
+				return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+			}
+			limit = n
+		}
+
+		suggestions, err := p.productUC.Suggest(ctx, c.QueryParam("q"), limit)
+		if err != nil {
+			p.log.Errorf("productUC.Suggest: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, suggestions)
+	}
+}
+
+// DeleteProduct Delete product
+// @Tags Products
+// @Summary Delete single product
+// @Description Soft delete single product by id, product can be restored later
+// @Accept json
+// @Produce json
+// @Param product_id path string true "product id"
+// @Success 200 {object} models.Operation
+// @Router /products/{product_id} [delete]
+func (p *productHandlers) DeleteProduct() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.Delete")
+		defer span.Finish()
+		deleteRequests.Inc()
+
+		prodID, err := primitive.ObjectIDFromHex(c.Param("product_id"))
+		if err != nil {
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		op, err := p.productUC.PublishDelete(ctx, prodID)
+		if err != nil {
+			p.log.Errorf("productUC.PublishDelete: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, op)
+	}
+}
+
+// ArchiveProduct Archive product
+// @Tags Products
+// @Summary Archive single product
+// @Description Archive (soft delete) single product by id
+// @Accept json
+// @Produce json
+// @Param product_id path string true "product id"
+// @Success 200 {object} models.Product
+// @Router /products/{product_id}/archive [post]
+func (p *productHandlers) ArchiveProduct() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.Archive")
+		defer span.Finish()
+		archiveRequests.Inc()
+
+		prodID, err := primitive.ObjectIDFromHex(c.Param("product_id"))
+		if err != nil {
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		prod, err := p.productUC.Archive(ctx, prodID)
+		if err != nil {
+			p.log.Errorf("productUC.Archive: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, prod)
+	}
+}
+
+// RestoreProduct Restore product
+// @Tags Products
+// @Summary Restore single product
+// @Description Restore single archived product by id
+// @Accept json
+// @Produce json
+// @Param product_id path string true "product id"
+// @Success 200 {object} models.Product
+// @Router /products/{product_id}/restore [post]
+func (p *productHandlers) RestoreProduct() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "productHandlers.Restore")
+		defer span.Finish()
+		restoreRequests.Inc()
+
+		prodID, err := primitive.ObjectIDFromHex(c.Param("product_id"))
+		if err != nil {
+			p.log.Errorf("primitive.ObjectIDFromHex: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		prod, err := p.productUC.Restore(ctx, prodID)
+		if err != nil {
+			p.log.Errorf("productUC.Restore: %v", err)
+			errorRequests.Inc()
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		successRequests.Inc()
+		return c.JSON(http.StatusOK, prod)
+	}
+}
+
+// getProductsFilter Parse search filter query params, empty params are not applied
+func getProductsFilter(c echo.Context) (*models.ProductsFilter, error) {
+	filter := &models.ProductsFilter{Language: c.QueryParam("language")}
+
+	if v := c.QueryParam("includeArchived"); v != "" {
+		includeArchived, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "includeArchived")
+		}
+		filter.IncludeArchived = includeArchived
+	}
+	if v := c.QueryParam("minPrice"); v != "" {
+		minPrice, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "minPrice")
+		}
+		filter.MinPrice = &minPrice
+	}
+	if v := c.QueryParam("maxPrice"); v != "" {
+		maxPrice, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "maxPrice")
+		}
+		filter.MaxPrice = &maxPrice
+	}
+	if v := c.QueryParam("minRating"); v != "" {
+		minRating, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "minRating")
+		}
+		filter.MinRating = &minRating
+	}
+	if v := c.QueryParam("inStock"); v != "" {
+		inStock, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "inStock")
+		}
+		filter.InStock = inStock
+	}
+	if v := c.QueryParam("facets"); v != "" {
+		for _, facet := range strings.Split(v, ",") {
+			filter.Facets = append(filter.Facets, strings.TrimSpace(facet))
+		}
+	}
+	if v := c.QueryParam("categoryIds"); v != "" {
+		for _, id := range strings.Split(v, ",") {
+			catID, err := primitive.ObjectIDFromHex(strings.TrimSpace(id))
+			if err != nil {
+				return nil, errors.Wrap(err, "categoryIds")
+			}
+			filter.CategoryIDs = append(filter.CategoryIDs, catID)
+		}
+	}
+
+	var err error
+	if filter.CreatedFrom, err = parseTimeParam(c, "createdFrom"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = parseTimeParam(c, "createdTo"); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedFrom, err = parseTimeParam(c, "updatedFrom"); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedTo, err = parseTimeParam(c, "updatedTo"); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func parseTimeParam(c echo.Context, name string) (*time.Time, error) {
+	v := c.QueryParam(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, errors.Wrap(err, name)
+	}
+	return &t, nil
+}
+
+// bulkWrite Check bulk size, validate products and write valid ones, invalid products are failed in result
+func (p *productHandlers) bulkWrite(
+	ctx context.Context,
+	products []*models.Product,
+	write func(ctx context.Context, products []*models.Product) (*models.BulkResult, error),
+) (*models.BulkResult, error) {
+	if err := models.ValidateBulkSize(len(products)); err != nil {
+		return nil, err
+	}
+
+	result := models.NewBulkResult(len(products))
+	valid := make([]*models.Product, 0, len(products))
+	indexes := make([]int, 0, len(products))
+	for i, prod := range products {
+		if prod == nil {
+			result.SetFailed(i, primitive.NilObjectID, httpErrors.BadRequest)
+			continue
+		}
+		if err := p.validate.StructCtx(ctx, prod); err != nil {
+			result.SetFailed(i, prod.ProductID, err)
+			continue
+		}
+		valid = append(valid, prod)
+		indexes = append(indexes, i)
+	}
+
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	written, err := write(ctx, valid)
+	if err != nil {
+		return nil, err
+	}
+	result.Merge(indexes, written)
+
+	return result, nil
+}
+
+// getWriteMode Get write mode from query param or header, config default is used when both are empty
+func (p *productHandlers) getWriteMode(c echo.Context) (string, error) {
+	writeMode := c.QueryParam(writeModeQueryParam)
+	if writeMode == "" {
+		writeMode = c.Request().Header.Get(writeModeHeader)
+	}
+	if writeMode == "" {
+		writeMode = p.cfg.Http.WriteMode
+	}
+
+	switch strings.ToLower(writeMode) {
+	case "", writeModeAsync:
+		return writeModeAsync, nil
+	case writeModeSync:
+		return writeModeSync, nil
+	default:
+		return "", errors.Errorf("invalid write mode: %q", writeMode)
+	}
+}
+
+// getETag Get strong ETag from product version, weak ETag from update time is used for products without version
+func getETag(prod *models.Product) string {
+	if prod.Version > 0 {
+		return strconv.Quote(strconv.FormatInt(prod.Version, 10))
+	}
+	if prod.UpdatedAt.IsZero() {
+		return ""
+	}
+	return "W/" + strconv.Quote(strconv.FormatInt(prod.UpdatedAt.UnixNano(), 10))
+}
+
+// setETag Set product ETag header
+func setETag(c echo.Context, prod *models.Product) {
+	if etag := getETag(prod); etag != "" {
+		c.Response().Header().Set(headerETag, etag)
+	}
+}
+
+// setCacheHeaders Set validators and Cache-Control of product read, clients must revalidate once max age is over
+func (p *productHandlers) setCacheHeaders(c echo.Context, prod *models.Product) {
+	setETag(c, prod)
+	if !prod.UpdatedAt.IsZero() {
+		c.Response().Header().Set(headerLastModified, prod.UpdatedAt.UTC().Format(http.TimeFormat))
+	}
+
+	if maxAge := p.cfg.Http.ProductCacheMaxAge; maxAge > 0 {
+		c.Response().Header().Set(headerCacheControl, fmt.Sprintf("public, max-age=%d, must-revalidate", maxAge))
+		return
+	}
+	c.Response().Header().Set(headerCacheControl, "no-cache")
+}
+
+// isNotModified Check conditional GET headers, If-Modified-Since is ignored when If-None-Match is present
+func isNotModified(c echo.Context, prod *models.Product) bool {
+	if ifNoneMatch := c.Request().Header.Get(headerIfNoneMatch); ifNoneMatch != "" {
+		etag := getETag(prod)
+		if etag == "" {
+			return false
+		}
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			tag = strings.TrimSpace(tag)
+			// weak comparison
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	ifModifiedSince := c.Request().Header.Get(headerIfModifiedSince)
+	if ifModifiedSince == "" || prod.UpdatedAt.IsZero() {
+		return false
+	}
+	t, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	// Last-Modified has seconds precision
+	return !prod.UpdatedAt.Truncate(time.Second).After(t)
+}
+
+// getIfMatchVersion Get expected product version from If-Match header, empty header or * skip the check
+func getIfMatchVersion(c echo.Context) (int64, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil {
+		return 0, errors.Errorf("invalid If-Match: %s", ifMatch)
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errors.Errorf("invalid If-Match: %s", ifMatch)
+	}
+	return version, nil
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+func newTestContext(headers map[string]string) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func TestGetETag(t *testing.T) {
+	updatedAt := time.Unix(1600000000, 123)
+
+	tests := []struct {
+		name    string
+		product *models.Product
+		etag    string
+	}{
+		{name: "strong from version", product: &models.Product{Version: 7, UpdatedAt: updatedAt}, etag: `"7"`},
+		{name: "weak from update time", product: &models.Product{UpdatedAt: updatedAt}, etag: `W/"1600000000000000123"`},
+		{name: "no validator", product: &models.Product{}, etag: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if etag := getETag(tt.product); etag != tt.etag {
+				t.Errorf("getETag = %s, want %s", etag, tt.etag)
+			}
+		})
+	}
+}
+
+func TestGetIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		version int64
+		invalid bool
+	}{
+		{name: "no header"},
+		{name: "any version", ifMatch: "*"},
+		{name: "strong etag", ifMatch: `"7"`, version: 7},
+		{name: "surrounding spaces", ifMatch: ` "12" `, version: 12},
+		{name: "unquoted", ifMatch: "7", invalid: true},
+		{name: "weak etag", ifMatch: `W/"7"`, invalid: true},
+		{name: "not a number", ifMatch: `"abc"`, invalid: true},
+		{name: "zero version", ifMatch: `"0"`, invalid: true},
+		{name: "negative version", ifMatch: `"-1"`, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := getIfMatchVersion(newTestContext(map[string]string{headerIfMatch: tt.ifMatch}))
+			if (err != nil) != tt.invalid {
+				t.Fatalf("err = %v, want invalid: %v", err, tt.invalid)
+			}
+			if version != tt.version {
+				t.Errorf("version = %d, want %d", version, tt.version)
+			}
+		})
+	}
+}
+
+func TestIsNotModified(t *testing.T) {
+	updatedAt := time.Date(2021, 1, 2, 3, 4, 5, 600, time.UTC)
+	withVersion := &models.Product{Version: 3, UpdatedAt: updatedAt}
+	withoutVersion := &models.Product{UpdatedAt: updatedAt}
+
+	tests := []struct {
+		name        string
+		product     *models.Product
+		headers     map[string]string
+		notModified bool
+	}{
+		{name: "no conditional headers", product: withVersion},
+		{name: "matching etag", product: withVersion, headers: map[string]string{headerIfNoneMatch: `"3"`}, notModified: true},
+		{name: "stale etag", product: withVersion, headers: map[string]string{headerIfNoneMatch: `"2"`}},
+		{name: "one of etags", product: withVersion, headers: map[string]string{headerIfNoneMatch: `"1", "3"`}, notModified: true},
+		{name: "weak comparison", product: withVersion, headers: map[string]string{headerIfNoneMatch: `W/"3"`}, notModified: true},
+		{name: "any etag", product: withVersion, headers: map[string]string{headerIfNoneMatch: "*"}, notModified: true},
+		{
+			name:        "weak etag of update time",
+			product:     withoutVersion,
+			headers:     map[string]string{headerIfNoneMatch: getETag(withoutVersion)},
+			notModified: true,
+		},
+		{
+			name:    "etag takes precedence over modification time",
+			product: withVersion,
+			headers: map[string]string{
+				headerIfNoneMatch:     `"2"`,
+				headerIfModifiedSince: updatedAt.Add(time.Hour).Format(http.TimeFormat),
+			},
+		},
+		{
+			name:        "not modified since second of update",
+			product:     withVersion,
+			headers:     map[string]string{headerIfModifiedSince: updatedAt.Format(http.TimeFormat)},
+			notModified: true,
+		},
+		{
+			name:    "modified after",
+			product: withVersion,
+			headers: map[string]string{headerIfModifiedSince: updatedAt.Add(-time.Second).Format(http.TimeFormat)},
+		},
+		{
+			name:    "invalid date",
+			product: withVersion,
+			headers: map[string]string{headerIfModifiedSince: "yesterday"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if notModified := isNotModified(newTestContext(tt.headers), tt.product); notModified != tt.notModified {
+				t.Errorf("isNotModified = %v, want %v", notModified, tt.notModified)
+			}
+		})
+	}
+}
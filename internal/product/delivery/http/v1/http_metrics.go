@@ -22,12 +22,52 @@ var (
 		Name: "http_products_update_incoming_requests_total",
 		Help: "The total number of incoming update product HTTP requests",
 	})
+	upsertRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_upsert_incoming_requests_total",
+		Help: "The total number of incoming upsert product HTTP requests",
+	})
+	patchRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_patch_incoming_requests_total",
+		Help: "The total number of incoming patch product HTTP requests",
+	})
 	getByIdRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "http_products_get_by_id_incoming_requests_total",
 		Help: "The total number of incoming get by id product HTTP requests",
 	})
+	getByIdsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_get_by_ids_incoming_requests_total",
+		Help: "The total number of incoming batch get products HTTP requests",
+	})
 	searchRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "http_products_search_incoming_requests_total",
 		Help: "The total number of incoming search products HTTP requests",
 	})
+	suggestRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_suggest_incoming_requests_total",
+		Help: "The total number of incoming suggest products HTTP requests",
+	})
+	deleteRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_delete_incoming_requests_total",
+		Help: "The total number of incoming delete product HTTP requests",
+	})
+	archiveRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_archive_incoming_requests_total",
+		Help: "The total number of incoming archive product HTTP requests",
+	})
+	restoreRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_restore_incoming_requests_total",
+		Help: "The total number of incoming restore product HTTP requests",
+	})
+	bulkCreateRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_bulk_create_incoming_requests_total",
+		Help: "The total number of incoming bulk create products HTTP requests",
+	})
+	bulkUpdateRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_bulk_update_incoming_requests_total",
+		Help: "The total number of incoming bulk update products HTTP requests",
+	})
+	notModifiedRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_products_not_modified_requests_total",
+		Help: "The total number of product HTTP requests answered with not modified",
+	})
 )
@@ -3,7 +3,16 @@ package v1
 // MapRoutes products routes
 func (p *productHandlers) MapRoutes() {
 	p.group.POST("", p.CreateProduct())
+	p.group.POST("/bulk", p.BulkCreateProducts())
+	p.group.PUT("/bulk", p.BulkUpdateProducts())
+	p.group.POST("/batch-get", p.BatchGetProducts())
 	p.group.PUT("/:product_id", p.UpdateProduct())
+	p.group.PATCH("/:product_id", p.PatchProduct())
+	p.group.PUT("/:product_id/upsert", p.UpsertProduct())
 	p.group.GET("/:product_id", p.GetByIDProduct())
 	p.group.GET("/search", p.SearchProduct())
+	p.group.GET("/suggest", p.SuggestProduct())
+	p.group.DELETE("/:product_id", p.DeleteProduct())
+	p.group.POST("/:product_id/archive", p.ArchiveProduct())
+	p.group.POST("/:product_id/restore", p.RestoreProduct())
 }
@@ -20,6 +20,10 @@ var (
 		Name: "products_error_incoming_kafka_message_total",
 		Help: "The total number of error incoming success Kafka messages",
 	})
+	deadLetterMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "products_dead_letter_kafka_messages_total",
+		Help: "The total number of incoming Kafka messages sent to dead letter queue by failure reason",
+	}, []string{"reason"})
 )
 
 const (
@@ -37,12 +41,23 @@ const (
 	writerRequiredAcks = -1
 	writerMaxAttempts  = 3
 
-	createProductTopic   = "create-product"
+	CreateProductTopic   = "create-product"
 	createProductWorkers = 3
-	updateProductTopic   = "update-product"
+	UpdateProductTopic   = "update-product"
 	updateProductWorkers = 3
+	DeleteProductTopic   = "delete-product"
+	deleteProductWorkers = 3
 
-	deadLetterQueueTopic = "dead-letter-queue"
+	DeadLetterQueueTopic = "dead-letter-queue"
 
 	productsGroupID = "products_group"
+
+	// OperationIDHeader message header with id of operation tracking message processing
+	OperationIDHeader = "operation-id"
+	// IdempotencyKeyHeader message header with client idempotency key of create request
+	IdempotencyKeyHeader = "idempotency-key"
+	// ReplayedFromHeader message header with id of dead letter message replayed by message
+	ReplayedFromHeader = "replayed-from"
+	// OriginalMessageIDHeader message header with id of first failed delivery, kept while message moves through retry topics
+	OriginalMessageIDHeader = "original-message-id"
 )
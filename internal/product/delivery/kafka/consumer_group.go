@@ -3,26 +3,31 @@ package kafka
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/segmentio/kafka-go"
 	"github.com/segmentio/kafka-go/compress"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/AleksK1NG/products-microservice/config"
 	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/internal/operation"
 	"github.com/AleksK1NG/products-microservice/internal/product"
 	"github.com/AleksK1NG/products-microservice/pkg/logger"
 )
 
 // ProductsConsumerGroup struct
 type ProductsConsumerGroup struct {
-	Brokers    []string
-	GroupID    string
-	log        logger.Logger
-	cfg        *config.Config
-	productsUC product.UseCase
-	validate   *validator.Validate
+	Brokers     []string
+	GroupID     string
+	log         logger.Logger
+	cfg         *config.Config
+	productsUC  product.UseCase
+	operationUC operation.UseCase
+	validate    *validator.Validate
 }
 
 // NewProductsConsumerGroup constructor
@@ -32,15 +37,17 @@ func NewProductsConsumerGroup(
 	log logger.Logger,
 	cfg *config.Config,
 	productsUC product.UseCase,
+	operationUC operation.UseCase,
 	validate *validator.Validate,
 ) *ProductsConsumerGroup {
 	return &ProductsConsumerGroup{
-		Brokers:    brokers,
-		GroupID:    groupID,
-		log:        log,
-		cfg:        cfg,
-		productsUC: productsUC,
-		validate:   validate,
+		Brokers:     brokers,
+		GroupID:     groupID,
+		log:         log,
+		cfg:         cfg,
+		productsUC:  productsUC,
+		operationUC: operationUC,
+		validate:    validate,
 	}
 }
 
@@ -96,7 +103,8 @@ func (pcg *ProductsConsumerGroup) consumeCreateProduct(
 		}
 	}()
 
-	w := pcg.getNewKafkaWriter(deadLetterQueueTopic)
+	// topic is set by message, failed messages are written to retry topics or dead letter queue
+	w := pcg.getNewKafkaWriter("")
 	defer func() {
 		if err := w.Close(); err != nil {
 			pcg.log.Errorf("w.Close", err)
@@ -130,7 +138,8 @@ func (pcg *ProductsConsumerGroup) consumeUpdateProduct(
 		}
 	}()
 
-	w := pcg.getNewKafkaWriter(deadLetterQueueTopic)
+	// topic is set by message, failed messages are written to retry topics or dead letter queue
+	w := pcg.getNewKafkaWriter("")
 	defer func() {
 		if err := w.Close(); err != nil {
 			pcg.log.Errorf("w.Close", err)
@@ -148,13 +157,73 @@ func (pcg *ProductsConsumerGroup) consumeUpdateProduct(
 	wg.Wait()
 }
 
-func (pcg *ProductsConsumerGroup) publishErrorMessage(ctx context.Context, w *kafka.Writer, m kafka.Message, err error) error {
+func (pcg *ProductsConsumerGroup) consumeDeleteProduct(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	groupID string,
+	topic string,
+	workersNum int,
+) {
+	r := pcg.getNewKafkaReader(pcg.Brokers, topic, groupID)
+	defer cancel()
+	defer func() {
+		if err := r.Close(); err != nil {
+			pcg.log.Errorf("r.Close", err)
+			cancel()
+		}
+	}()
+
+	// topic is set by message, failed messages are written to retry topics or dead letter queue
+	w := pcg.getNewKafkaWriter("")
+	defer func() {
+		if err := w.Close(); err != nil {
+			pcg.log.Errorf("w.Close", err)
+			cancel()
+		}
+	}()
+
+	pcg.log.Infof("Starting consumer group: %v", r.Config().GroupID)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workersNum; i++ {
+		wg.Add(1)
+		go pcg.deleteProductWorker(ctx, cancel, r, w, wg, i)
+	}
+	wg.Wait()
+}
+
+// publishErrorMessage Publish failed message with its payload and processing details to dead letter queue,
+// dead letter message key is message id so redelivered failures of the same message share it
+func (pcg *ProductsConsumerGroup) publishErrorMessage(
+	ctx context.Context,
+	w *kafka.Writer,
+	m kafka.Message,
+	reason string,
+	err error,
+	groupID string,
+	workerID int,
+	attempts int,
+) error {
+	headers := make([]models.ErrorMessageHeader, 0, len(m.Headers))
+	for _, header := range m.Headers {
+		headers = append(headers, models.ErrorMessageHeader{Key: header.Key, Value: header.Value})
+	}
+
 	errMsg := &models.ErrorMessage{
-		Offset:    m.Offset,
-		Error:     err.Error(),
-		Time:      m.Time.UTC(),
-		Partition: m.Partition,
-		Topic:     m.Topic,
+		MessageID:     getMessageID(m),
+		Offset:        m.Offset,
+		Error:         err.Error(),
+		Reason:        reason,
+		Time:          m.Time.UTC(),
+		Partition:     m.Partition,
+		Topic:         m.Topic,
+		Key:           m.Key,
+		Value:         m.Value,
+		Headers:       headers,
+		Attempts:      attempts,
+		ConsumerGroup: groupID,
+		WorkerID:      workerID,
+		FailedAt:      time.Now().UTC(),
 	}
 
 	errMsgBytes, err := json.Marshal(errMsg)
@@ -163,12 +232,75 @@ func (pcg *ProductsConsumerGroup) publishErrorMessage(ctx context.Context, w *ka
 	}
 
 	return w.WriteMessages(ctx, kafka.Message{
+		Topic: DeadLetterQueueTopic,
+		Key:   []byte(errMsg.MessageID),
 		Value: errMsgBytes,
 	})
 }
 
+// getMessageID Get id of message which stays the same across redeliveries and retry topics
+func getMessageID(m kafka.Message) string {
+	if messageID := getHeader(m, OriginalMessageIDHeader); messageID != "" {
+		return messageID
+	}
+	return fmt.Sprintf("%s-%d-%d", m.Topic, m.Partition, m.Offset)
+}
+
 // RunConsumers run kafka consumers
 func (pcg *ProductsConsumerGroup) RunConsumers(ctx context.Context, cancel context.CancelFunc) {
-	go pcg.consumeCreateProduct(ctx, cancel, productsGroupID, createProductTopic, createProductWorkers)
-	go pcg.consumeUpdateProduct(ctx, cancel, productsGroupID, updateProductTopic, updateProductWorkers)
+	go pcg.consumeCreateProduct(ctx, cancel, productsGroupID, CreateProductTopic, createProductWorkers)
+	go pcg.consumeUpdateProduct(ctx, cancel, productsGroupID, UpdateProductTopic, updateProductWorkers)
+	go pcg.consumeDeleteProduct(ctx, cancel, productsGroupID, DeleteProductTopic, deleteProductWorkers)
+
+	for _, topic := range []string{CreateProductTopic, UpdateProductTopic, DeleteProductTopic} {
+		for _, tier := range retryTiers {
+			go pcg.consumeRetry(ctx, cancel, productsGroupID, getRetryTopic(topic, tier))
+		}
+	}
+}
+
+// setOperationResult Report processing result to operation tracked by message header, nil err means success
+func (pcg *ProductsConsumerGroup) setOperationResult(ctx context.Context, m kafka.Message, err error) {
+	operationID := getHeader(m, OperationIDHeader)
+	if operationID == "" {
+		return
+	}
+
+	if err == nil {
+		if err := pcg.operationUC.SetSucceeded(ctx, operationID); err != nil {
+			pcg.log.Errorf("operationUC.SetSucceeded: %v", err)
+		}
+		return
+	}
+
+	if err := pcg.operationUC.SetFailed(ctx, operationID, err); err != nil {
+		pcg.log.Errorf("operationUC.SetFailed: %v", err)
+	}
+}
+
+// releaseIdempotencyKey Release idempotency key of create message which failed, so client can retry request with the same key
+func (pcg *ProductsConsumerGroup) releaseIdempotencyKey(ctx context.Context, m kafka.Message) {
+	key := getHeader(m, IdempotencyKeyHeader)
+	if key == "" {
+		return
+	}
+
+	productID, err := primitive.ObjectIDFromHex(string(m.Key))
+	if err != nil {
+		pcg.log.Errorf("releaseIdempotencyKey message %s key: %v", getMessageID(m), err)
+		return
+	}
+
+	if err := pcg.productsUC.ReleaseIdempotencyKey(ctx, key, productID); err != nil {
+		pcg.log.Errorf("productsUC.ReleaseIdempotencyKey: %v", err)
+	}
+}
+
+func getHeader(m kafka.Message, key string) string {
+	for _, header := range m.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
 }
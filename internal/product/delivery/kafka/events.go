@@ -0,0 +1,123 @@
+package kafka
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	productsService "github.com/AleksK1NG/products-microservice/proto/product"
+)
+
+const (
+	// ContentTypeHeader message header with encoding of message value, messages without it are JSON
+	ContentTypeHeader = "content-type"
+	// SchemaVersionHeader message header with major.minor version of event schema
+	SchemaVersionHeader = "schema-version"
+
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+
+	// ProductEventsSchemaVersion version of proto/product/product_events.proto messages, major is changed only by breaking changes
+	ProductEventsSchemaVersion = "1.0"
+	productEventsMajorVersion  = 1
+)
+
+var (
+	errUnsupportedContentType   = errors.New("unsupported content type")
+	errUnsupportedSchemaVersion = errors.New("unsupported schema version")
+)
+
+// EventHeaders Get content type and schema version headers of published product events
+func EventHeaders() []kafka.Header {
+	return []kafka.Header{
+		{Key: ContentTypeHeader, Value: []byte(ContentTypeProtobuf)},
+		{Key: SchemaVersionHeader, Value: []byte(ProductEventsSchemaVersion)},
+	}
+}
+
+// EncodeProduct Encode product as value of create-product and update-product messages
+func EncodeProduct(product *models.Product) ([]byte, error) {
+	return proto.Marshal(product.ToEventProto())
+}
+
+// EncodeDeleteProduct Encode product id as value of delete-product message
+func EncodeDeleteProduct(productID primitive.ObjectID) ([]byte, error) {
+	return proto.Marshal(&productsService.ProductDeletedEvent{ProductID: productID.Hex()})
+}
+
+// decodeProduct Decode value of create-product and update-product messages, JSON is accepted until all producers publish protobuf
+func decodeProduct(m kafka.Message) (*models.Product, error) {
+	contentType, err := getContentType(m)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType == ContentTypeJSON {
+		var prod models.Product
+		if err := json.Unmarshal(m.Value, &prod); err != nil {
+			return nil, errors.Wrap(err, "json.Unmarshal")
+		}
+		return &prod, nil
+	}
+
+	var event productsService.ProductEvent
+	if err := proto.Unmarshal(m.Value, &event); err != nil {
+		return nil, errors.Wrap(err, "proto.Unmarshal")
+	}
+	return models.ProductFromEventProto(&event)
+}
+
+// decodeDeleteProduct Decode value of delete-product message, JSON is accepted until all producers publish protobuf
+func decodeDeleteProduct(m kafka.Message) (*models.DeleteProductMessage, error) {
+	contentType, err := getContentType(m)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType == ContentTypeJSON {
+		var msg models.DeleteProductMessage
+		if err := json.Unmarshal(m.Value, &msg); err != nil {
+			return nil, errors.Wrap(err, "json.Unmarshal")
+		}
+		return &msg, nil
+	}
+
+	var event productsService.ProductDeletedEvent
+	if err := proto.Unmarshal(m.Value, &event); err != nil {
+		return nil, errors.Wrap(err, "proto.Unmarshal")
+	}
+	prodID, err := primitive.ObjectIDFromHex(event.GetProductID())
+	if err != nil {
+		return nil, err
+	}
+	return &models.DeleteProductMessage{ProductID: prodID}, nil
+}
+
+// getContentType Get supported content type of message, protobuf messages must have known major schema version
+func getContentType(m kafka.Message) (string, error) {
+	contentType := getHeader(m, ContentTypeHeader)
+	if contentType == "" {
+		contentType = ContentTypeJSON
+	}
+	if contentType != ContentTypeJSON && contentType != ContentTypeProtobuf {
+		return "", errors.Wrapf(errUnsupportedContentType, "content type: %s", contentType)
+	}
+
+	version := getHeader(m, SchemaVersionHeader)
+	if version == "" && contentType == ContentTypeJSON {
+		return contentType, nil
+	}
+
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil || major != productEventsMajorVersion {
+		return "", errors.Wrapf(errUnsupportedSchemaVersion, "schema version: %q", version)
+	}
+
+	return contentType, nil
+}
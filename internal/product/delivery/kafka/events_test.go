@@ -0,0 +1,88 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+func newHeadersMessage(headers ...string) kafka.Message {
+	m := kafka.Message{}
+	for i := 0; i+1 < len(headers); i += 2 {
+		m.Headers = append(m.Headers, kafka.Header{Key: headers[i], Value: []byte(headers[i+1])})
+	}
+	return m
+}
+
+func TestGetContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     kafka.Message
+		contentType string
+		err         error
+	}{
+		{
+			name:        "legacy JSON without headers",
+			message:     newHeadersMessage(),
+			contentType: ContentTypeJSON,
+		},
+		{
+			name:        "produced protobuf",
+			message:     kafka.Message{Headers: EventHeaders()},
+			contentType: ContentTypeProtobuf,
+		},
+		{
+			name:        "newer minor version",
+			message:     newHeadersMessage(ContentTypeHeader, ContentTypeProtobuf, SchemaVersionHeader, "1.7"),
+			contentType: ContentTypeProtobuf,
+		},
+		{
+			name:        "major version only",
+			message:     newHeadersMessage(ContentTypeHeader, ContentTypeProtobuf, SchemaVersionHeader, "1"),
+			contentType: ContentTypeProtobuf,
+		},
+		{
+			name:        "JSON with known version",
+			message:     newHeadersMessage(ContentTypeHeader, ContentTypeJSON, SchemaVersionHeader, "1.0"),
+			contentType: ContentTypeJSON,
+		},
+		{
+			name:    "unknown major version",
+			message: newHeadersMessage(ContentTypeHeader, ContentTypeProtobuf, SchemaVersionHeader, "2.0"),
+			err:     errUnsupportedSchemaVersion,
+		},
+		{
+			name:    "protobuf without version",
+			message: newHeadersMessage(ContentTypeHeader, ContentTypeProtobuf),
+			err:     errUnsupportedSchemaVersion,
+		},
+		{
+			name:    "JSON with unknown major version",
+			message: newHeadersMessage(SchemaVersionHeader, "0.9"),
+			err:     errUnsupportedSchemaVersion,
+		},
+		{
+			name:    "malformed version",
+			message: newHeadersMessage(ContentTypeHeader, ContentTypeProtobuf, SchemaVersionHeader, "v1"),
+			err:     errUnsupportedSchemaVersion,
+		},
+		{
+			name:    "unsupported content type",
+			message: newHeadersMessage(ContentTypeHeader, "application/avro", SchemaVersionHeader, "1.0"),
+			err:     errUnsupportedContentType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, err := getContentType(tt.message)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if contentType != tt.contentType {
+				t.Errorf("content type = %q, want %q", contentType, tt.contentType)
+			}
+		})
+	}
+}
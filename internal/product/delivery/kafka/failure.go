@@ -0,0 +1,41 @@
+package kafka
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	categoryErrors "github.com/AleksK1NG/products-microservice/pkg/category_errors"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+)
+
+// businessErrors errors which processing the same message again can not fix
+var businessErrors = []error{
+	productErrors.ErrProductNotFound,
+	productErrors.ErrProductAlreadyExists,
+	productErrors.ErrVersionConflict,
+	productErrors.ErrObjectIDTypeConversion,
+	categoryErrors.ErrCategoryNotFound,
+	categoryErrors.ErrInvalidParentCategory,
+}
+
+// getFailureReason Classify error returned by products use case
+func getFailureReason(err error) string {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return models.FailureReasonValidation
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return models.FailureReasonBusiness
+		}
+	}
+
+	return models.FailureReasonTransient
+}
+
+// isRetryable Only transient failures can succeed on retry, the rest go straight to dead letter queue
+func isRetryable(reason string) bool {
+	return reason == models.FailureReasonTransient
+}
@@ -0,0 +1,28 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		reason    string
+		retryable bool
+	}{
+		{reason: models.FailureReasonTransient, retryable: true},
+		{reason: models.FailureReasonBusiness},
+		{reason: models.FailureReasonValidation},
+		{reason: models.FailureReasonDecode},
+		{reason: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			if retryable := isRetryable(tt.reason); retryable != tt.retryable {
+				t.Errorf("isRetryable(%q) = %v, want %v", tt.reason, retryable, tt.retryable)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 type ProductsProducer interface {
 	PublishCreate(ctx context.Context, msgs ...kafka.Message) error
 	PublishUpdate(ctx context.Context, msgs ...kafka.Message) error
+	PublishDelete(ctx context.Context, msgs ...kafka.Message) error
 	Close()
 	Run()
 	GetNewKafkaWriter(topic string) *kafka.Writer
@@ -24,6 +25,7 @@ type productsProducer struct {
 	cfg          *config.Config
 	createWriter *kafka.Writer
 	updateWriter *kafka.Writer
+	deleteWriter *kafka.Writer
 }
 
 // NewProductsProducer constructor
@@ -50,14 +52,16 @@ func (p *productsProducer) GetNewKafkaWriter(topic string) *kafka.Writer {
 
 // Run init producers writers
 func (p *productsProducer) Run() {
-	p.createWriter = p.GetNewKafkaWriter(createProductTopic)
-	p.updateWriter = p.GetNewKafkaWriter(updateProductTopic)
+	p.createWriter = p.GetNewKafkaWriter(CreateProductTopic)
+	p.updateWriter = p.GetNewKafkaWriter(UpdateProductTopic)
+	p.deleteWriter = p.GetNewKafkaWriter(DeleteProductTopic)
 }
 
 // Close close writers
 func (p productsProducer) Close() {
 	p.createWriter.Close()
 	p.updateWriter.Close()
+	p.deleteWriter.Close()
 }
 
 // PublishCreate publish messages to create topic
@@ -69,3 +73,8 @@ func (p *productsProducer) PublishCreate(ctx context.Context, msgs ...kafka.Mess
 func (p *productsProducer) PublishUpdate(ctx context.Context, msgs ...kafka.Message) error {
 	return p.updateWriter.WriteMessages(ctx, msgs...)
 }
+
+// PublishDelete publish messages to delete topic
+func (p *productsProducer) PublishDelete(ctx context.Context, msgs ...kafka.Message) error {
+	return p.deleteWriter.WriteMessages(ctx, msgs...)
+}
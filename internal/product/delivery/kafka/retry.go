@@ -0,0 +1,276 @@
+package kafka
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// RetryAttemptHeader message header with number of failed processing attempts
+	RetryAttemptHeader = "retry-attempt"
+	// RetryDueAtHeader message header with time when retried message is republished to original topic
+	RetryDueAtHeader = "retry-due-at"
+	// OriginalTopicHeader message header with topic retried message is republished to
+	OriginalTopicHeader = "original-topic"
+
+	defaultMaxAttempts = 3
+
+	// publishAttempts failed message is published until it succeeds or consumer is stopped
+	publishAttempts = math.MaxUint32
+	publishDelay    = time.Second
+	publishMaxDelay = time.Minute
+)
+
+// retryTier retry topic suffix and delay before message is processed again
+type retryTier struct {
+	suffix string
+	delay  time.Duration
+}
+
+// retryTiers delays of consecutive retries, last tier is used for all further attempts
+var retryTiers = []retryTier{
+	{suffix: "retry-1m", delay: time.Minute},
+	{suffix: "retry-10m", delay: 10 * time.Minute},
+}
+
+// RetryTopics Get retry topics of topic
+func RetryTopics(topic string) []string {
+	topics := make([]string, 0, len(retryTiers))
+	for _, tier := range retryTiers {
+		topics = append(topics, getRetryTopic(topic, tier))
+	}
+	return topics
+}
+
+func getRetryTopic(topic string, tier retryTier) string {
+	return topic + "-" + tier.suffix
+}
+
+// getRetryTier Get tier of failed attempt, attempts start from 1
+func getRetryTier(attempt int) retryTier {
+	if attempt > len(retryTiers) {
+		return retryTiers[len(retryTiers)-1]
+	}
+	return retryTiers[attempt-1]
+}
+
+// getAttempt Get number of failed attempts of message
+func getAttempt(m kafka.Message) int {
+	attempt, err := strconv.Atoi(getHeader(m, RetryAttemptHeader))
+	if err != nil {
+		return 0
+	}
+	return attempt
+}
+
+// getMaxAttempts Get configured number of processing attempts before message goes to dead letter queue
+func (pcg *ProductsConsumerGroup) getMaxAttempts() int {
+	if pcg.cfg.Kafka.MaxAttempts > 0 {
+		return pcg.cfg.Kafka.MaxAttempts
+	}
+	return defaultMaxAttempts
+}
+
+// retryOrDeadLetter Publish failed message to retry topic of its attempt, non retryable failures and messages which used max attempts
+// go to dead letter queue, message is committed after it is handed over and operation is failed only when message goes to dead letter queue.
+// Publish is retried until consumer is stopped, so message is never committed before it is handed over
+func (pcg *ProductsConsumerGroup) retryOrDeadLetter(
+	ctx context.Context,
+	r *kafka.Reader,
+	w *kafka.Writer,
+	m kafka.Message,
+	reason string,
+	err error,
+	workerID int,
+) error {
+	errorMessages.Inc()
+
+	attempt := getAttempt(m) + 1
+	if !isRetryable(reason) || attempt >= pcg.getMaxAttempts() {
+		if publishErr := pcg.publish(ctx, func() error {
+			return pcg.publishErrorMessage(ctx, w, m, reason, err, r.Config().GroupID, workerID, attempt)
+		}); publishErr != nil {
+			return errors.Wrap(publishErr, "publishErrorMessage")
+		}
+		pcg.setOperationResult(ctx, m, err)
+		pcg.releaseIdempotencyKey(ctx, m)
+		deadLetterMessages.WithLabelValues(reason).Inc()
+		pcg.log.Errorf("message %s is sent to dead letter queue after %d attempts, reason: %s: %v", getMessageID(m), attempt, reason, err)
+	} else {
+		if err := pcg.publish(ctx, func() error {
+			return pcg.publishRetryMessage(ctx, w, m, attempt)
+		}); err != nil {
+			return errors.Wrap(err, "publishRetryMessage")
+		}
+		pcg.log.Infof("message %s is scheduled for retry after attempt %d: %v", getMessageID(m), attempt, err)
+	}
+
+	if err := r.CommitMessages(ctx, m); err != nil {
+		pcg.log.Errorf("CommitMessages: %v", err)
+	}
+	return nil
+}
+
+// publish Call publish with growing delay until it succeeds, error is returned only when ctx is done
+func (pcg *ProductsConsumerGroup) publish(ctx context.Context, publish func() error) error {
+	return retry.Do(
+		publish,
+		retry.Attempts(publishAttempts),
+		retry.Delay(publishDelay),
+		retry.MaxDelay(publishMaxDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.Context(ctx),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(n uint, err error) {
+			pcg.log.Errorf("publish attempt %d: %v", n+1, err)
+		}),
+	)
+}
+
+// publishRetryMessage Publish message to retry topic of attempt with due time, original topic and original message id headers
+func (pcg *ProductsConsumerGroup) publishRetryMessage(ctx context.Context, w *kafka.Writer, m kafka.Message, attempt int) error {
+	tier := getRetryTier(attempt)
+
+	headers := setHeader(m.Headers, OriginalMessageIDHeader, getMessageID(m))
+	headers = setHeader(headers, RetryAttemptHeader, strconv.Itoa(attempt))
+	headers = setHeader(headers, RetryDueAtHeader, time.Now().Add(tier.delay).UTC().Format(time.RFC3339Nano))
+	headers = setHeader(headers, OriginalTopicHeader, m.Topic)
+
+	return w.WriteMessages(ctx, kafka.Message{
+		Topic:   getRetryTopic(m.Topic, tier),
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	})
+}
+
+// consumeRetry Fetch messages of retry topic and hand them over to worker of their partition,
+// message which is not due yet holds back only its own partition
+func (pcg *ProductsConsumerGroup) consumeRetry(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	groupID string,
+	topic string,
+) {
+	r := pcg.getNewKafkaReader(pcg.Brokers, topic, groupID)
+	defer cancel()
+	defer func() {
+		if err := r.Close(); err != nil {
+			pcg.log.Errorf("r.Close", err)
+			cancel()
+		}
+	}()
+
+	w := pcg.getNewKafkaWriter("")
+	defer func() {
+		if err := w.Close(); err != nil {
+			pcg.log.Errorf("w.Close", err)
+			cancel()
+		}
+	}()
+
+	pcg.log.Infof("Starting retry consumer group: %v, topic: %v", r.Config().GroupID, topic)
+
+	wg := &sync.WaitGroup{}
+	partitions := make(map[int]chan kafka.Message)
+	defer func() {
+		for _, messages := range partitions {
+			close(messages)
+		}
+		wg.Wait()
+	}()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			pcg.log.Errorf("FetchMessage", err)
+			return
+		}
+
+		messages, ok := partitions[m.Partition]
+		if !ok {
+			messages = make(chan kafka.Message, queueCapacity)
+			partitions[m.Partition] = messages
+			wg.Add(1)
+			go pcg.retryWorker(ctx, cancel, r, w, wg, messages, m.Partition)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case messages <- m:
+		}
+	}
+}
+
+// retryWorker Wait until retried message of partition is due and republish it to original topic, messages of partition are handled
+// in order so commit never advances past message which is not republished, messages of retry topic have the same delay
+// so waiting for the first one does not delay the rest
+func (pcg *ProductsConsumerGroup) retryWorker(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	r *kafka.Reader,
+	w *kafka.Writer,
+	wg *sync.WaitGroup,
+	messages <-chan kafka.Message,
+	partition int,
+) {
+	defer wg.Done()
+	defer cancel()
+
+	for m := range messages {
+		if dueAt, err := time.Parse(time.RFC3339Nano, getHeader(m, RetryDueAtHeader)); err == nil {
+			if wait := time.Until(dueAt); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(wait):
+				}
+			}
+		}
+
+		originalTopic := getHeader(m, OriginalTopicHeader)
+		if originalTopic == "" {
+			pcg.log.Errorf("PARTITION: %v, retry message %s has no original topic", partition, getMessageID(m))
+			if err := r.CommitMessages(ctx, m); err != nil {
+				pcg.log.Errorf("CommitMessages", err)
+			}
+			continue
+		}
+
+		if err := pcg.publish(ctx, func() error {
+			return w.WriteMessages(ctx, kafka.Message{
+				Topic:   originalTopic,
+				Key:     m.Key,
+				Value:   m.Value,
+				Headers: m.Headers,
+			})
+		}); err != nil {
+			pcg.log.Errorf("WriteMessages", err)
+			return
+		}
+
+		if err := r.CommitMessages(ctx, m); err != nil {
+			pcg.log.Errorf("CommitMessages", err)
+			continue
+		}
+	}
+}
+
+// setHeader Get copy of headers with value of key replaced or added
+func setHeader(headers []kafka.Header, key string, value string) []kafka.Header {
+	result := make([]kafka.Header, 0, len(headers)+1)
+	for _, header := range headers {
+		if header.Key != key {
+			result = append(result, header)
+		}
+	}
+	return append(result, kafka.Header{Key: key, Value: []byte(value)})
+}
@@ -0,0 +1,44 @@
+package kafka
+
+import (
+	"testing"
+)
+
+func TestGetAttempt(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		attempt int
+	}{
+		{name: "first delivery", attempt: 0},
+		{name: "retried message", headers: []string{RetryAttemptHeader, "2"}, attempt: 2},
+		{name: "malformed header", headers: []string{RetryAttemptHeader, "two"}, attempt: 0},
+		{name: "empty header", headers: []string{RetryAttemptHeader, ""}, attempt: 0},
+		{name: "other headers", headers: []string{OriginalTopicHeader, CreateProductTopic, RetryAttemptHeader, "1"}, attempt: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if attempt := getAttempt(newHeadersMessage(tt.headers...)); attempt != tt.attempt {
+				t.Errorf("getAttempt = %d, want %d", attempt, tt.attempt)
+			}
+		})
+	}
+}
+
+func TestGetRetryTier(t *testing.T) {
+	tests := []struct {
+		attempt int
+		topic   string
+	}{
+		{attempt: 1, topic: "create-product-retry-1m"},
+		{attempt: 2, topic: "create-product-retry-10m"},
+		{attempt: 5, topic: "create-product-retry-10m"},
+	}
+
+	for _, tt := range tests {
+		if topic := getRetryTopic(CreateProductTopic, getRetryTier(tt.attempt)); topic != tt.topic {
+			t.Errorf("retry topic of attempt %d = %s, want %s", tt.attempt, topic, tt.topic)
+		}
+	}
+}
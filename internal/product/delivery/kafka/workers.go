@@ -2,19 +2,13 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"sync"
-	"time"
 
-	"github.com/avast/retry-go"
+	"github.com/pkg/errors"
 	"github.com/segmentio/kafka-go"
 
 	"github.com/AleksK1NG/products-microservice/internal/models"
-)
-
-const (
-	retryAttempts = 1
-	retryDelay    = 1 * time.Second
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
 )
 
 func (pcg *ProductsConsumerGroup) createProductWorker(
@@ -46,41 +40,16 @@ func (pcg *ProductsConsumerGroup) createProductWorker(
 		)
 		incomingMessages.Inc()
 
-		var prod models.Product
-		if err := json.Unmarshal(m.Value, &prod); err != nil {
-			errorMessages.Inc()
-			pcg.log.Errorf("json.Unmarshal", err)
-			continue
-		}
-
-		if err := pcg.validate.StructCtx(ctx, prod); err != nil {
-			errorMessages.Inc()
-			pcg.log.Errorf("validate.StructCtx", err)
-			continue
-		}
-
-		if err := retry.Do(func() error {
-			created, err := pcg.productsUC.Create(ctx, &prod)
-			if err != nil {
-				return err
+		if reason, err := pcg.handleCreateProduct(ctx, m); err != nil {
+			if err := pcg.retryOrDeadLetter(ctx, r, w, m, reason, err, workerID); err != nil {
+				pcg.log.Errorf("retryOrDeadLetter: %v", err)
+				return
 			}
-			pcg.log.Infof("created product: %v", created)
-			return nil
-		},
-			retry.Attempts(retryAttempts),
-			retry.Delay(retryDelay),
-			retry.Context(ctx),
-		); err != nil {
-			errorMessages.Inc()
-
-			if err := pcg.publishErrorMessage(ctx, w, m, err); err != nil {
-				pcg.log.Errorf("publishErrorMessage", err)
-				continue
-			}
-			pcg.log.Errorf("productsUC.Create.publishErrorMessage", err)
 			continue
 		}
 
+		pcg.setOperationResult(ctx, m, nil)
+
 		if err := r.CommitMessages(ctx, m); err != nil {
 			errorMessages.Inc()
 			pcg.log.Errorf("CommitMessages", err)
@@ -120,41 +89,65 @@ func (pcg *ProductsConsumerGroup) updateProductWorker(
 		)
 		incomingMessages.Inc()
 
-		var prod models.Product
-		if err := json.Unmarshal(m.Value, &prod); err != nil {
-			errorMessages.Inc()
-			pcg.log.Errorf("json.Unmarshal", err)
+		if reason, err := pcg.handleUpdateProduct(ctx, m); err != nil {
+			if err := pcg.retryOrDeadLetter(ctx, r, w, m, reason, err, workerID); err != nil {
+				pcg.log.Errorf("retryOrDeadLetter: %v", err)
+				return
+			}
 			continue
 		}
 
-		if err := pcg.validate.StructCtx(ctx, prod); err != nil {
+		pcg.setOperationResult(ctx, m, nil)
+
+		if err := r.CommitMessages(ctx, m); err != nil {
 			errorMessages.Inc()
-			pcg.log.Errorf("validate.StructCtx", err)
+			pcg.log.Errorf("CommitMessages", err)
 			continue
 		}
 
-		if err := retry.Do(func() error {
-			updated, err := pcg.productsUC.Update(ctx, &prod)
-			if err != nil {
-				return err
-			}
-			pcg.log.Debugf("updated product: %v", updated)
-			return nil
-		},
-			retry.Attempts(retryAttempts),
-			retry.Delay(retryDelay),
-			retry.Context(ctx),
-		); err != nil {
-			errorMessages.Inc()
+		successMessages.Inc()
+	}
+}
+
+func (pcg *ProductsConsumerGroup) deleteProductWorker(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	r *kafka.Reader,
+	w *kafka.Writer,
+	wg *sync.WaitGroup,
+	workerID int,
+) {
+	defer wg.Done()
+	defer cancel()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			pcg.log.Errorf("FetchMessage", err)
+			return
+		}
+
+		pcg.log.Infof(
+			"WORKER: %v, message at topic/partition/offset %v/%v/%v: %s = %s\n",
+			workerID,
+			m.Topic,
+			m.Partition,
+			m.Offset,
+			string(m.Key),
+			string(m.Value),
+		)
+		incomingMessages.Inc()
 
-			if err := pcg.publishErrorMessage(ctx, w, m, err); err != nil {
-				pcg.log.Errorf("publishErrorMessage", err)
-				continue
+		if reason, err := pcg.handleDeleteProduct(ctx, m); err != nil {
+			if err := pcg.retryOrDeadLetter(ctx, r, w, m, reason, err, workerID); err != nil {
+				pcg.log.Errorf("retryOrDeadLetter: %v", err)
+				return
 			}
-			pcg.log.Errorf("productsUC.Create.publishErrorMessage", err)
 			continue
 		}
 
+		pcg.setOperationResult(ctx, m, nil)
+
 		if err := r.CommitMessages(ctx, m); err != nil {
 			errorMessages.Inc()
 			pcg.log.Errorf("CommitMessages", err)
@@ -164,3 +157,74 @@ func (pcg *ProductsConsumerGroup) updateProductWorker(
 		successMessages.Inc()
 	}
 }
+
+// handleCreateProduct Create product of message, failure reason tells if message can be retried
+func (pcg *ProductsConsumerGroup) handleCreateProduct(ctx context.Context, m kafka.Message) (string, error) {
+	prod, err := decodeProduct(m)
+	if err != nil {
+		pcg.log.Errorf("decodeProduct", err)
+		return models.FailureReasonDecode, err
+	}
+	prod.IdempotencyKey = getHeader(m, IdempotencyKeyHeader)
+
+	if err := pcg.validate.StructCtx(ctx, prod); err != nil {
+		pcg.log.Errorf("validate.StructCtx", err)
+		return models.FailureReasonValidation, err
+	}
+
+	created, err := pcg.productsUC.Create(ctx, prod)
+	if errors.Is(err, productErrors.ErrProductAlreadyExists) {
+		// redelivered message, product with pre-assigned id is already created
+		pcg.log.Infof("product already created: %v", prod.ProductID)
+		return "", nil
+	}
+	if err != nil {
+		return getFailureReason(err), err
+	}
+
+	pcg.log.Infof("created product: %v", created)
+	return "", nil
+}
+
+// handleUpdateProduct Update product of message, failure reason tells if message can be retried
+func (pcg *ProductsConsumerGroup) handleUpdateProduct(ctx context.Context, m kafka.Message) (string, error) {
+	prod, err := decodeProduct(m)
+	if err != nil {
+		pcg.log.Errorf("decodeProduct", err)
+		return models.FailureReasonDecode, err
+	}
+
+	if err := pcg.validate.StructCtx(ctx, prod); err != nil {
+		pcg.log.Errorf("validate.StructCtx", err)
+		return models.FailureReasonValidation, err
+	}
+
+	updated, err := pcg.productsUC.Update(ctx, prod)
+	if err != nil {
+		return getFailureReason(err), err
+	}
+
+	pcg.log.Debugf("updated product: %v", updated)
+	return "", nil
+}
+
+// handleDeleteProduct Delete product of message, failure reason tells if message can be retried
+func (pcg *ProductsConsumerGroup) handleDeleteProduct(ctx context.Context, m kafka.Message) (string, error) {
+	msg, err := decodeDeleteProduct(m)
+	if err != nil {
+		pcg.log.Errorf("decodeDeleteProduct", err)
+		return models.FailureReasonDecode, err
+	}
+
+	if err := pcg.validate.StructCtx(ctx, msg); err != nil {
+		pcg.log.Errorf("validate.StructCtx", err)
+		return models.FailureReasonValidation, err
+	}
+
+	if err := pcg.productsUC.Delete(ctx, msg.ProductID); err != nil {
+		return getFailureReason(err), err
+	}
+
+	pcg.log.Debugf("deleted product: %v", msg.ProductID)
+	return "", nil
+}
@@ -0,0 +1,186 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/config"
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/internal/product"
+	categoryErrors "github.com/AleksK1NG/products-microservice/pkg/category_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+)
+
+// productsUCStub products use case returning err the way real use case wraps repository errors
+type productsUCStub struct {
+	product.UseCase
+	err   error
+	calls int
+}
+
+func (s *productsUCStub) Create(ctx context.Context, prod *models.Product) (*models.Product, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, errors.Wrap(s.err, "Create")
+	}
+	return prod, nil
+}
+
+func (s *productsUCStub) Update(ctx context.Context, prod *models.Product) (*models.Product, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, errors.Wrap(s.err, "Update")
+	}
+	return prod, nil
+}
+
+func (s *productsUCStub) Delete(ctx context.Context, productID primitive.ObjectID) error {
+	s.calls++
+	if s.err != nil {
+		return errors.Wrap(s.err, "Delete")
+	}
+	return nil
+}
+
+func newTestConsumerGroup(productsUC product.UseCase) *ProductsConsumerGroup {
+	cfg := &config.Config{Logger: config.Logger{Level: "fatal", Encoding: "console"}}
+	appLogger := logger.NewApiLogger(cfg)
+	appLogger.InitLogger()
+	return NewProductsConsumerGroup(nil, productsGroupID, appLogger, cfg, productsUC, nil, validator.New())
+}
+
+func newProductMessage(t *testing.T, prod *models.Product) kafka.Message {
+	value, err := EncodeProduct(prod)
+	if err != nil {
+		t.Fatalf("EncodeProduct: %v", err)
+	}
+	return kafka.Message{Value: value, Headers: EventHeaders()}
+}
+
+func newValidProduct() *models.Product {
+	return &models.Product{
+		ProductID:   primitive.NewObjectID(),
+		Name:        "product",
+		Description: "description",
+		Price:       10,
+		Quantity:    1,
+		Rating:      5,
+	}
+}
+
+func TestHandleProductFailureReason(t *testing.T) {
+	validationErr := validator.New().Struct(&models.Product{})
+
+	tests := []struct {
+		name      string
+		handle    func(pcg *ProductsConsumerGroup, ctx context.Context, m kafka.Message) (string, error)
+		message   kafka.Message
+		useCase   error
+		reason    string
+		wantCalls int
+	}{
+		{
+			name:      "update of missing product",
+			handle:    (*ProductsConsumerGroup).handleUpdateProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   errors.Wrap(productErrors.ErrProductNotFound, "product id"),
+			reason:    models.FailureReasonBusiness,
+			wantCalls: 1,
+		},
+		{
+			name:      "update with stale version",
+			handle:    (*ProductsConsumerGroup).handleUpdateProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   errors.Wrap(productErrors.ErrVersionConflict, "expected version"),
+			reason:    models.FailureReasonBusiness,
+			wantCalls: 1,
+		},
+		{
+			name:      "create with missing category",
+			handle:    (*ProductsConsumerGroup).handleCreateProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   categoryErrors.ErrCategoryNotFound,
+			reason:    models.FailureReasonBusiness,
+			wantCalls: 1,
+		},
+		{
+			name:      "delete of missing product",
+			handle:    (*ProductsConsumerGroup).handleDeleteProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   productErrors.ErrProductNotFound,
+			reason:    models.FailureReasonBusiness,
+			wantCalls: 1,
+		},
+		{
+			name:      "use case validation",
+			handle:    (*ProductsConsumerGroup).handleUpdateProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   validationErr,
+			reason:    models.FailureReasonValidation,
+			wantCalls: 1,
+		},
+		{
+			name:      "database unavailable",
+			handle:    (*ProductsConsumerGroup).handleUpdateProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   errors.New("server selection timeout"),
+			reason:    models.FailureReasonTransient,
+			wantCalls: 1,
+		},
+		{
+			name:      "redelivered create",
+			handle:    (*ProductsConsumerGroup).handleCreateProduct,
+			message:   newProductMessage(t, newValidProduct()),
+			useCase:   productErrors.ErrProductAlreadyExists,
+			wantCalls: 1,
+		},
+		{
+			name:    "undecodable value",
+			handle:  (*ProductsConsumerGroup).handleCreateProduct,
+			message: kafka.Message{Value: []byte("{")},
+			reason:  models.FailureReasonDecode,
+		},
+		{
+			name:   "unknown schema major version",
+			handle: (*ProductsConsumerGroup).handleUpdateProduct,
+			message: kafka.Message{Value: newProductMessage(t, newValidProduct()).Value, Headers: []kafka.Header{
+				{Key: ContentTypeHeader, Value: []byte(ContentTypeProtobuf)},
+				{Key: SchemaVersionHeader, Value: []byte("2.0")},
+			}},
+			reason: models.FailureReasonDecode,
+		},
+		{
+			name:    "invalid product",
+			handle:  (*ProductsConsumerGroup).handleCreateProduct,
+			message: newProductMessage(t, &models.Product{ProductID: primitive.NewObjectID()}),
+			reason:  models.FailureReasonValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productsUC := &productsUCStub{err: tt.useCase}
+			pcg := newTestConsumerGroup(productsUC)
+
+			reason, err := tt.handle(pcg, context.Background(), tt.message)
+			if reason != tt.reason {
+				t.Errorf("reason = %q, want %q, err: %v", reason, tt.reason, err)
+			}
+			if (err != nil) != (tt.reason != "") {
+				t.Errorf("err = %v, want failure: %v", err, tt.reason != "")
+			}
+			if productsUC.calls != tt.wantCalls {
+				t.Errorf("use case calls = %d, want %d", productsUC.calls, tt.wantCalls)
+			}
+			if tt.reason != "" && tt.reason != models.FailureReasonTransient && isRetryable(reason) {
+				t.Errorf("reason %q must not be retried", reason)
+			}
+		})
+	}
+}
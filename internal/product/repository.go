@@ -13,8 +13,18 @@ import (
 type MongoRepository interface {
 	Create(ctx context.Context, product *models.Product) (*models.Product, error)
 	Update(ctx context.Context, product *models.Product) (*models.Product, error)
+	Patch(ctx context.Context, product *models.Product, fields []string) (*models.Product, error)
+	Upsert(ctx context.Context, product *models.Product) (*models.Product, bool, error)
+	BulkCreate(ctx context.Context, products []*models.Product) (*models.BulkResult, error)
+	BulkUpdate(ctx context.Context, products []*models.Product) (*models.BulkResult, error)
 	GetByID(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
-	Search(ctx context.Context, search string, pagination *utils.Pagination) (*models.ProductsList, error)
+	GetByIDs(ctx context.Context, productIDs []primitive.ObjectID) ([]*models.Product, error)
+	Search(ctx context.Context, search string, filter *models.ProductsFilter, pagination *utils.Pagination) (*models.ProductsList, error)
+	Facets(ctx context.Context, search string, filter *models.ProductsFilter) (*models.ProductFacets, error)
+	ListByCategory(ctx context.Context, categoryIDs []primitive.ObjectID, pagination *utils.Pagination) (*models.ProductsList, error)
+	Suggest(ctx context.Context, prefix string, limit int) ([]*models.ProductSuggestion, error)
+	Archive(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
+	Restore(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
 }
 
 // RedisRepository Product
@@ -22,4 +32,13 @@ type RedisRepository interface {
 	SetProduct(ctx context.Context, product *models.Product) error
 	GetProductByID(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
 	DeleteProduct(ctx context.Context, productID primitive.ObjectID) error
+	GetProductsByIDs(ctx context.Context, productIDs []primitive.ObjectID) ([]*models.Product, error)
+	SetProducts(ctx context.Context, products []*models.Product) error
+	GetSuggestionsVersion(ctx context.Context) (int64, error)
+	InvalidateSuggestions(ctx context.Context) error
+	SetSuggestions(ctx context.Context, version int64, prefix string, limit int, suggestions []*models.ProductSuggestion) error
+	GetSuggestions(ctx context.Context, version int64, prefix string, limit int) ([]*models.ProductSuggestion, error)
+	ReserveIdempotencyKey(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, bool, error)
+	SetIdempotencyRecord(ctx context.Context, record *models.IdempotencyRecord) error
+	DeleteIdempotencyRecord(ctx context.Context, key string, productID primitive.ObjectID) error
 }
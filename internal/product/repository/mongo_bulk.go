@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+)
+
+// BulkCreate Insert products in single unordered bulk write, failed inserts do not stop the rest
+func (p *productMongoRepo) BulkCreate(ctx context.Context, products []*models.Product) (*models.BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.BulkCreate")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	now := time.Now().UTC()
+	writes := make([]mongo.WriteModel, 0, len(products))
+	for _, product := range products {
+		if product.ProductID.IsZero() {
+			product.ProductID = primitive.NewObjectID()
+		}
+		setCreateFields(product, now)
+		writes = append(writes, mongo.NewInsertOneModel().SetDocument(product))
+	}
+
+	_, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	failed, err := getBulkWriteErrors(err)
+	if err != nil {
+		return nil, errors.Wrap(err, "BulkWrite")
+	}
+
+	result := models.NewBulkResult(len(products))
+	for i, product := range products {
+		if writeErr, ok := failed[i]; ok {
+			result.SetFailed(i, product.ProductID, writeErr)
+			continue
+		}
+		result.SetSucceeded(i, product.ProductID)
+	}
+
+	return result, nil
+}
+
+// BulkUpdate Replace active products in single unordered bulk write, products with version are updated only if it matches stored one,
+// product ids must be unique
+func (p *productMongoRepo) BulkUpdate(ctx context.Context, products []*models.Product) (*models.BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.BulkUpdate")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	// bulk write reports only total matched count, write token tells products written by this request apart
+	token := primitive.NewObjectID()
+	now := time.Now().UTC()
+	writes := make([]mongo.WriteModel, 0, len(products))
+	for _, product := range products {
+		product.WriteToken = token
+		f := withVersion(bson.M{"_id": product.ProductID, "status": notArchived()}, product.Version)
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(f).SetUpdate(getReplaceUpdate(product, now)))
+	}
+
+	res, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	failed, err := getBulkWriteErrors(err)
+	if err != nil {
+		return nil, errors.Wrap(err, "BulkWrite")
+	}
+
+	result := models.NewBulkResult(len(products))
+	if int(res.MatchedCount)+len(failed) == len(products) {
+		for i, product := range products {
+			if writeErr, ok := failed[i]; ok {
+				result.SetFailed(i, product.ProductID, writeErr)
+				continue
+			}
+			result.SetSucceeded(i, product.ProductID)
+		}
+		if err := p.unsetWriteToken(ctx, token); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	writeTokens, err := p.getWriteTokens(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+	for i, product := range products {
+		if writeErr, ok := failed[i]; ok {
+			result.SetFailed(i, product.ProductID, writeErr)
+			continue
+		}
+
+		stored, ok := writeTokens[product.ProductID]
+		switch {
+		case ok && stored == token:
+			result.SetSucceeded(i, product.ProductID)
+		case ok && product.Version > 0:
+			result.SetFailed(i, product.ProductID, errors.Wrapf(productErrors.ErrVersionConflict, "expected version: %d", product.Version))
+		default:
+			result.SetFailed(i, product.ProductID, productErrors.ErrProductNotFound)
+		}
+	}
+
+	if err := p.unsetWriteToken(ctx, token); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// unsetWriteToken Remove token of resolved bulk update, so it is not kept with products
+func (p *productMongoRepo) unsetWriteToken(ctx context.Context, token primitive.ObjectID) error {
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	if _, err := collection.UpdateMany(ctx, bson.M{"writeToken": token}, bson.M{"$unset": bson.M{"writeToken": ""}}); err != nil {
+		return errors.Wrap(err, "UpdateMany")
+	}
+	return nil
+}
+
+// getWriteTokens Get write tokens of stored active products
+func (p *productMongoRepo) getWriteTokens(ctx context.Context, products []*models.Product) (map[primitive.ObjectID]primitive.ObjectID, error) {
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	ids := make([]primitive.ObjectID, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.ProductID)
+	}
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "writeToken": 1})
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "status": notArchived()}, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "Find")
+	}
+	defer cursor.Close(ctx)
+
+	writeTokens := make(map[primitive.ObjectID]primitive.ObjectID, len(ids))
+	for cursor.Next(ctx) {
+		var prod models.Product
+		if err := cursor.Decode(&prod); err != nil {
+			return nil, errors.Wrap(err, "Decode")
+		}
+		writeTokens[prod.ProductID] = prod.WriteToken
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "cursor.Err")
+	}
+
+	return writeTokens, nil
+}
+
+// getBulkWriteErrors Get write errors by index of write model, error is returned if bulk write failed as a whole
+func getBulkWriteErrors(err error) (map[int]error, error) {
+	failed := make(map[int]error)
+	if err == nil {
+		return failed, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return nil, err
+	}
+
+	for _, writeErr := range bulkErr.WriteErrors {
+		if writeErr.Code == duplicateKeyErrorCode {
+			failed[writeErr.Index] = errors.Wrap(productErrors.ErrProductAlreadyExists, writeErr.Message)
+			continue
+		}
+		failed[writeErr.Index] = errors.New(writeErr.Message)
+	}
+	return failed, nil
+}
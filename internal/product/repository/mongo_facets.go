@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"math"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+const (
+	categoryFacetLimit = 50
+	priceFacetBuckets  = 5
+	maxRating          = 10
+	// ratingFacetOther bucket of ratings out of boundaries, it is not returned
+	ratingFacetOther = -1
+)
+
+// ratingFacetBoundaries lower bounds of rating buckets, last boundary is exclusive upper bound of rating 10
+var ratingFacetBoundaries = []float64{0, 2, 4, 6, 8, 11}
+
+type priceBucket struct {
+	ID struct {
+		Min float64 `bson:"min"`
+		Max float64 `bson:"max"`
+	} `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+type ratingBucket struct {
+	ID    float64 `bson:"_id"`
+	Count int64   `bson:"count"`
+}
+
+// facetsResult single document returned by $facet stage
+type facetsResult struct {
+	Categories []*models.CategoryFacet `bson:"category"`
+	Price      []priceBucket           `bson:"price"`
+	Rating     []ratingBucket          `bson:"rating"`
+}
+
+// getFacetStages Build $facet sub pipelines of requested facets, missing price and rating are counted as zero
+func getFacetStages(filter *models.ProductsFilter) bson.D {
+	stages := bson.D{}
+	if filter.HasFacet(models.ProductFacetCategory) {
+		stages = append(stages, bson.E{Key: models.ProductFacetCategory, Value: bson.A{
+			bson.D{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$categoryId"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}}},
+			bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+			bson.D{{Key: "$limit", Value: categoryFacetLimit}},
+		}})
+	}
+	if filter.HasFacet(models.ProductFacetPrice) {
+		stages = append(stages, bson.E{Key: models.ProductFacetPrice, Value: bson.A{
+			bson.D{{Key: "$bucketAuto", Value: bson.D{
+				{Key: "groupBy", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$price", 0}}}},
+				{Key: "buckets", Value: priceFacetBuckets},
+			}}},
+		}})
+	}
+	if filter.HasFacet(models.ProductFacetRating) {
+		stages = append(stages, bson.E{Key: models.ProductFacetRating, Value: bson.A{
+			bson.D{{Key: "$bucket", Value: bson.D{
+				{Key: "groupBy", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$rating", 0}}}},
+				{Key: "boundaries", Value: ratingFacetBoundaries},
+				{Key: "default", Value: ratingFacetOther},
+			}}},
+		}})
+	}
+	return stages
+}
+
+func (r *facetsResult) toFacets(filter *models.ProductsFilter) *models.ProductFacets {
+	facets := &models.ProductFacets{}
+
+	if filter.HasFacet(models.ProductFacetCategory) {
+		facets.Categories = r.Categories
+	}
+
+	if filter.HasFacet(models.ProductFacetPrice) {
+		facets.Price = make([]*models.RangeFacet, 0, len(r.Price))
+		for _, b := range r.Price {
+			facets.Price = append(facets.Price, &models.RangeFacet{From: b.ID.Min, To: b.ID.Max, Count: b.Count})
+		}
+	}
+
+	if filter.HasFacet(models.ProductFacetRating) {
+		facets.Rating = make([]*models.RangeFacet, 0, len(r.Rating))
+		for _, b := range r.Rating {
+			if b.ID == ratingFacetOther {
+				continue
+			}
+			facets.Rating = append(facets.Rating, &models.RangeFacet{From: b.ID, To: nextRatingBoundary(b.ID), Count: b.Count})
+		}
+	}
+
+	return facets
+}
+
+// nextRatingBoundary Get upper bound of rating bucket, the last bucket is closed at max rating
+func nextRatingBoundary(from float64) float64 {
+	for _, boundary := range ratingFacetBoundaries {
+		if boundary > from {
+			return math.Min(boundary, maxRating)
+		}
+	}
+	return maxRating
+}
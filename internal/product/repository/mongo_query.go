@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+// searchCursor keyset position, values of sort keys of boundary product, last value is always _id
+type searchCursor struct {
+	OrderBy  string          `bson:"o"`
+	Values   []bson.RawValue `bson:"v"`
+	Backward bool            `bson:"b,omitempty"`
+}
+
+// getSearchFilter Build search query, text search and structured filter are combined with AND
+func getSearchFilter(text string, filter *models.ProductsFilter) bson.D {
+	f := bson.D{}
+	if text != "" {
+		search := bson.M{"$search": text}
+		if filter.Language != "" {
+			search["$language"] = filter.Language
+		}
+		f = append(f, bson.E{Key: "$text", Value: search})
+	}
+
+	if !filter.IncludeArchived {
+		f = append(f, bson.E{Key: "status", Value: notArchived()})
+	}
+
+	price := bson.M{}
+	if filter.MinPrice != nil {
+		price["$gte"] = *filter.MinPrice
+	}
+	if filter.MaxPrice != nil {
+		price["$lte"] = *filter.MaxPrice
+	}
+	if len(price) > 0 {
+		f = append(f, bson.E{Key: "price", Value: price})
+	}
+
+	if filter.MinRating != nil {
+		f = append(f, bson.E{Key: "rating", Value: bson.M{"$gte": *filter.MinRating}})
+	}
+	if filter.InStock {
+		f = append(f, bson.E{Key: "quantity", Value: bson.M{"$gt": 0}})
+	}
+	if len(filter.CategoryIDs) > 0 {
+		f = append(f, bson.E{Key: "categoryId", Value: bson.M{"$in": filter.CategoryIDs}})
+	}
+	if dates := getDateRange(filter.CreatedFrom, filter.CreatedTo); len(dates) > 0 {
+		f = append(f, bson.E{Key: "createdAt", Value: dates})
+	}
+	if dates := getDateRange(filter.UpdatedFrom, filter.UpdatedTo); len(dates) > 0 {
+		f = append(f, bson.E{Key: "updatedAt", Value: dates})
+	}
+
+	return f
+}
+
+func getDateRange(from, to *time.Time) bson.M {
+	dates := bson.M{}
+	if from != nil {
+		dates["$gte"] = from.UTC()
+	}
+	if to != nil {
+		dates["$lte"] = to.UTC()
+	}
+	return dates
+}
+
+// getSort Build sort from pagination order by, only whitelisted fields are allowed
+func getSort(pagination *utils.Pagination, textSearch bool) (bson.D, error) {
+	sortFields, err := pagination.GetSortFields()
+	if err != nil {
+		return nil, errors.Wrap(productErrors.ErrInvalidOrderBy, err.Error())
+	}
+
+	sort := make(bson.D, 0, len(sortFields)+1)
+	for _, sortField := range sortFields {
+		key, ok := models.ProductSortFields[sortField.Field]
+		if !ok {
+			return nil, errors.Wrapf(productErrors.ErrInvalidOrderBy, "unknown field: %q", sortField.Field)
+		}
+		// relevance is always descending and exists only for text search, otherwise natural order is kept
+		if sortField.Field == models.ProductSortRelevance {
+			if textSearch {
+				sort = append(sort, bson.E{Key: "score", Value: textScore()})
+			}
+			continue
+		}
+
+		direction := 1
+		if sortField.Desc {
+			direction = -1
+		}
+		sort = append(sort, bson.E{Key: key, Value: direction})
+	}
+
+	// text search results are ranked by relevance unless order by is set
+	if len(sortFields) == 0 && textSearch {
+		sort = append(sort, bson.E{Key: "score", Value: textScore()})
+	}
+
+	// _id tie breaker keeps pages stable for equal sort keys, it follows the last field direction
+	// so single field sorts can use {field: 1, _id: 1} indexes in both directions
+	if len(sort) > 0 {
+		direction, ok := sort[len(sort)-1].Value.(int)
+		if !ok {
+			direction = 1
+		}
+		sort = append(sort, bson.E{Key: "_id", Value: direction})
+	}
+
+	return sort, nil
+}
+
+func textScore() bson.M {
+	return bson.M{"$meta": "textScore"}
+}
+
+// escapeTextSearch Turn user input into plain terms, phrase and negation operators of $text are not exposed
+func escapeTextSearch(search string) string {
+	terms := strings.Fields(strings.NewReplacer(`"`, " ", `\`, " ").Replace(search))
+	escaped := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if term = strings.TrimLeft(term, "-"); term != "" {
+			escaped = append(escaped, term)
+		}
+	}
+	return strings.Join(escaped, " ")
+}
+
+// findPage Find single page of products matching filter, page is selected by cursor when it is set and by offset otherwise
+func (p *productMongoRepo) findPage(
+	ctx context.Context,
+	f bson.D,
+	sort bson.D,
+	opts *options.FindOptions,
+	pagination *utils.Pagination,
+) (*models.ProductsList, error) {
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	// keyset pagination needs total order, text score can not be used in query filter
+	keyset := !hasTextScore(sort)
+	if keyset && len(sort) == 0 {
+		sort = bson.D{{Key: "_id", Value: 1}}
+	}
+
+	var count int64
+	if !pagination.SkipCount {
+		var err error
+		count, err = collection.CountDocuments(ctx, f)
+		if err != nil {
+			return nil, errors.Wrap(err, "CountDocuments")
+		}
+	}
+
+	var cursor *searchCursor
+	findFilter := f
+	if pagination.GetCursor() != "" {
+		if !keyset {
+			return nil, errors.Wrap(productErrors.ErrInvalidCursor, "cursor can not be used with relevance order")
+		}
+
+		c, err := decodeCursor(pagination.GetCursor())
+		if err != nil {
+			return nil, errors.Wrap(productErrors.ErrInvalidCursor, err.Error())
+		}
+		if c.OrderBy != pagination.GetOrderBy() || len(c.Values) != len(sort) {
+			return nil, errors.Wrap(productErrors.ErrInvalidCursor, "cursor was issued for another order")
+		}
+		cursor = c
+
+		findFilter = append(bson.D{}, f...)
+		findFilter = append(findFilter, bson.E{Key: "$or", Value: getKeysetFilter(sort, c)})
+		if c.Backward {
+			sort = reverseSort(sort)
+		}
+	} else {
+		opts.SetSkip(int64(pagination.GetOffset()))
+	}
+
+	size := pagination.GetSize()
+	cur, err := collection.Find(ctx, findFilter, opts.SetSort(sort).SetLimit(int64(size+1)))
+	if err != nil {
+		return nil, errors.Wrap(err, "Find")
+	}
+	defer cur.Close(ctx)
+
+	products := make([]*models.Product, 0, size+1)
+	raws := make([]bson.Raw, 0, size+1)
+	for cur.Next(ctx) {
+		var prod models.Product
+		if err := cur.Decode(&prod); err != nil {
+			return nil, errors.Wrap(err, "Find")
+		}
+		products = append(products, &prod)
+		raws = append(raws, append(bson.Raw{}, cur.Current...))
+	}
+
+	if err := cur.Err(); err != nil {
+		return nil, errors.Wrap(err, "cursor.Err")
+	}
+
+	hasMore := len(products) > size
+	if hasMore {
+		products = products[:size]
+		raws = raws[:size]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		for i, j := 0, len(products)-1; i < j; i, j = i+1, j-1 {
+			products[i], products[j] = products[j], products[i]
+			raws[i], raws[j] = raws[j], raws[i]
+		}
+	}
+
+	list := &models.ProductsList{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(size),
+		HasMore:    hasMore || backward,
+		Products:   products,
+	}
+	if cursor != nil {
+		list.Page = 0
+	}
+
+	if keyset && len(raws) > 0 {
+		hasNext := hasMore || backward
+		hasPrev := (backward && hasMore) || (!backward && (cursor != nil || pagination.GetOffset() > 0))
+		if hasNext {
+			if list.NextCursor, err = encodeCursor(sort, raws[len(raws)-1], pagination.GetOrderBy(), false); err != nil {
+				return nil, errors.Wrap(err, "encodeCursor")
+			}
+		}
+		if hasPrev {
+			if list.PrevCursor, err = encodeCursor(sort, raws[0], pagination.GetOrderBy(), true); err != nil {
+				return nil, errors.Wrap(err, "encodeCursor")
+			}
+		}
+	}
+
+	return list, nil
+}
+
+func hasTextScore(sort bson.D) bool {
+	for _, e := range sort {
+		if _, ok := e.Value.(int); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+func reverseSort(sort bson.D) bson.D {
+	reversed := make(bson.D, 0, len(sort))
+	for _, e := range sort {
+		reversed = append(reversed, bson.E{Key: e.Key, Value: -e.Value.(int)})
+	}
+	return reversed
+}
+
+// getKeysetFilter Match products after cursor position in sort order, or before it for backward cursor:
+// (k1 > v1) or (k1 = v1 and k2 > v2) or ... with missing fields treated as null, which sorts first
+func getKeysetFilter(sort bson.D, c *searchCursor) bson.A {
+	or := make(bson.A, 0, len(sort))
+	for i, e := range sort {
+		clause := bson.D{}
+		for j := 0; j < i; j++ {
+			clause = append(clause, bson.E{Key: sort[j].Key, Value: equalTo(c.Values[j])})
+		}
+
+		ascending := e.Value.(int) > 0
+		if c.Backward {
+			ascending = !ascending
+		}
+
+		v := c.Values[i]
+		switch {
+		case ascending && v.Type == bsontype.Null:
+			clause = append(clause, bson.E{Key: e.Key, Value: bson.M{"$ne": nil}})
+		case ascending:
+			clause = append(clause, bson.E{Key: e.Key, Value: bson.M{"$gt": v}})
+		case e.Key == "_id":
+			clause = append(clause, bson.E{Key: e.Key, Value: bson.M{"$lt": v}})
+		case v.Type == bsontype.Null:
+			// nothing sorts before null
+			continue
+		default:
+			clause = append(clause, bson.E{Key: "$or", Value: bson.A{
+				bson.D{{Key: e.Key, Value: bson.M{"$lt": v}}},
+				bson.D{{Key: e.Key, Value: nil}},
+			}})
+		}
+		or = append(or, clause)
+	}
+	return or
+}
+
+func equalTo(v bson.RawValue) interface{} {
+	if v.Type == bsontype.Null {
+		return nil
+	}
+	return v
+}
+
+func encodeCursor(sort bson.D, raw bson.Raw, orderBy string, backward bool) (string, error) {
+	c := &searchCursor{OrderBy: orderBy, Values: make([]bson.RawValue, 0, len(sort)), Backward: backward}
+	for _, e := range sort {
+		v, err := raw.LookupErr(e.Key)
+		if err != nil {
+			v = bson.RawValue{Type: bsontype.Null}
+		}
+		c.Values = append(c.Values, v)
+	}
+
+	cursorBytes, err := bson.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "bson.Marshal")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodeCursor(token string) (*searchCursor, error) {
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "base64.DecodeString")
+	}
+
+	var c searchCursor
+	if err := bson.Unmarshal(cursorBytes, &c); err != nil {
+		return nil, errors.Wrap(err, "bson.Unmarshal")
+	}
+	if len(c.Values) == 0 {
+		return nil, errors.New("empty cursor")
+	}
+
+	return &c, nil
+}
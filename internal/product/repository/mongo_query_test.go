@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
+	"github.com/AleksK1NG/products-microservice/pkg/utils"
+)
+
+var cursorProductID, _ = primitive.ObjectIDFromHex("5f1d7f3e2b9a4c0012345678")
+
+func newRawValue(t *testing.T, value interface{}) bson.RawValue {
+	valueType, data, err := bson.MarshalValue(value)
+	if err != nil {
+		t.Fatalf("bson.MarshalValue: %v", err)
+	}
+	return bson.RawValue{Type: valueType, Value: data}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	raw, err := bson.Marshal(bson.D{{Key: "_id", Value: cursorProductID}, {Key: "price", Value: 10.5}})
+	if err != nil {
+		t.Fatalf("bson.Marshal: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		sort     bson.D
+		orderBy  string
+		backward bool
+		values   []bson.RawValue
+	}{
+		{
+			name:    "sort keys of product",
+			sort:    bson.D{{Key: "price", Value: 1}, {Key: "_id", Value: 1}},
+			orderBy: "price",
+			values:  []bson.RawValue{newRawValue(t, 10.5), newRawValue(t, cursorProductID)},
+		},
+		{
+			name:     "backward cursor",
+			sort:     bson.D{{Key: "_id", Value: -1}},
+			backward: true,
+			values:   []bson.RawValue{newRawValue(t, cursorProductID)},
+		},
+		{
+			name:    "missing field is null",
+			sort:    bson.D{{Key: "rating", Value: -1}, {Key: "_id", Value: -1}},
+			orderBy: "rating:desc",
+			values:  []bson.RawValue{{Type: bsontype.Null}, newRawValue(t, cursorProductID)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := encodeCursor(tt.sort, raw, tt.orderBy, tt.backward)
+			if err != nil {
+				t.Fatalf("encodeCursor: %v", err)
+			}
+
+			c, err := decodeCursor(token)
+			if err != nil {
+				t.Fatalf("decodeCursor: %v", err)
+			}
+			if c.OrderBy != tt.orderBy || c.Backward != tt.backward {
+				t.Errorf("cursor order by, backward = %q, %v, want %q, %v", c.OrderBy, c.Backward, tt.orderBy, tt.backward)
+			}
+			if len(c.Values) != len(tt.values) {
+				t.Fatalf("values = %v, want %v", c.Values, tt.values)
+			}
+			for i, want := range tt.values {
+				if !c.Values[i].Equal(want) {
+					t.Errorf("values[%d] = %v, want %v", i, c.Values[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	emptyCursor, err := bson.Marshal(&searchCursor{OrderBy: "price"})
+	if err != nil {
+		t.Fatalf("bson.Marshal: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "not a cursor!"},
+		{name: "not bson", token: "bm90IGJzb24"},
+		{name: "padded base64", token: "bm90IGJzb24="},
+		{name: "without values", token: base64.RawURLEncoding.EncodeToString(emptyCursor)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if c, err := decodeCursor(tt.token); err == nil {
+				t.Errorf("decodeCursor = %+v, want error", c)
+			}
+		})
+	}
+}
+
+func TestGetKeysetFilter(t *testing.T) {
+	price := newRawValue(t, 10.5)
+	id := newRawValue(t, cursorProductID)
+	null := bson.RawValue{Type: bsontype.Null}
+	ascending := bson.D{{Key: "price", Value: 1}, {Key: "_id", Value: 1}}
+	descending := bson.D{{Key: "price", Value: -1}, {Key: "_id", Value: -1}}
+
+	tests := []struct {
+		name   string
+		sort   bson.D
+		cursor *searchCursor
+		filter string
+	}{
+		{
+			name:   "ascending",
+			sort:   ascending,
+			cursor: &searchCursor{Values: []bson.RawValue{price, id}},
+			filter: `{"$or":[{"price":{"$gt":10.5}},{"price":10.5,"_id":{"$gt":{"$oid":"5f1d7f3e2b9a4c0012345678"}}}]}`,
+		},
+		{
+			name:   "descending includes missing values",
+			sort:   descending,
+			cursor: &searchCursor{Values: []bson.RawValue{price, id}},
+			filter: `{"$or":[{"$or":[{"price":{"$lt":10.5}},{"price":null}]},{"price":10.5,"_id":{"$lt":{"$oid":"5f1d7f3e2b9a4c0012345678"}}}]}`,
+		},
+		{
+			name:   "backward ascending is descending",
+			sort:   ascending,
+			cursor: &searchCursor{Values: []bson.RawValue{price, id}, Backward: true},
+			filter: `{"$or":[{"$or":[{"price":{"$lt":10.5}},{"price":null}]},{"price":10.5,"_id":{"$lt":{"$oid":"5f1d7f3e2b9a4c0012345678"}}}]}`,
+		},
+		{
+			name:   "ascending after null",
+			sort:   ascending,
+			cursor: &searchCursor{Values: []bson.RawValue{null, id}},
+			filter: `{"$or":[{"price":{"$ne":null}},{"price":null,"_id":{"$gt":{"$oid":"5f1d7f3e2b9a4c0012345678"}}}]}`,
+		},
+		{
+			name:   "descending after null",
+			sort:   descending,
+			cursor: &searchCursor{Values: []bson.RawValue{null, id}},
+			filter: `{"$or":[{"price":null,"_id":{"$lt":{"$oid":"5f1d7f3e2b9a4c0012345678"}}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := bson.MarshalExtJSON(bson.D{{Key: "$or", Value: getKeysetFilter(tt.sort, tt.cursor)}}, false, false)
+			if err != nil {
+				t.Fatalf("bson.MarshalExtJSON: %v", err)
+			}
+			if string(filter) != tt.filter {
+				t.Errorf("filter = %s, want %s", filter, tt.filter)
+			}
+		})
+	}
+}
+
+func TestGetSort(t *testing.T) {
+	tests := []struct {
+		name       string
+		orderBy    string
+		textSearch bool
+		sort       bson.D
+		err        error
+	}{
+		{
+			name: "natural order",
+			sort: bson.D{},
+		},
+		{
+			name:       "text search is ranked by relevance",
+			textSearch: true,
+			sort:       bson.D{{Key: "score", Value: textScore()}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:    "tie breaker follows last field",
+			orderBy: "price:desc",
+			sort:    bson.D{{Key: "price", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			name:    "many fields",
+			orderBy: "rating:DESC, name",
+			sort:    bson.D{{Key: "rating", Value: -1}, {Key: "name", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:    "relevance without text search",
+			orderBy: "relevance",
+			sort:    bson.D{},
+		},
+		{
+			name:    "field which is not whitelisted",
+			orderBy: "nameLower",
+			err:     productErrors.ErrInvalidOrderBy,
+		},
+		{
+			name:    "unknown direction",
+			orderBy: "price:up",
+			err:     productErrors.ErrInvalidOrderBy,
+		},
+		{
+			name:    "empty field",
+			orderBy: "price,,name",
+			err:     productErrors.ErrInvalidOrderBy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := getSort(&utils.Pagination{OrderBy: tt.orderBy}, tt.textSearch)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(sort, tt.sort) {
+				t.Errorf("sort = %v, want %v", sort, tt.sort)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
@@ -19,6 +20,8 @@ import (
 const (
 	productsDB         = "products"
 	productsCollection = "products"
+
+	duplicateKeyErrorCode = 11000
 )
 
 // productMongoRepo
@@ -31,18 +34,20 @@ func NewProductMongoRepo(mongoDB *mongo.Client) *productMongoRepo {
 	return &productMongoRepo{mongoDB: mongoDB}
 }
 
-// Create Create new product
+// Create Create new product, pre-assigned product id is kept
 func (p *productMongoRepo) Create(ctx context.Context, product *models.Product) (*models.Product, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Create")
 	defer span.Finish()
 
 	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
 
-	product.CreatedAt = time.Now().UTC()
-	product.UpdatedAt = time.Now().UTC()
+	setCreateFields(product, time.Now().UTC())
 
 	result, err := collection.InsertOne(ctx, product, &options.InsertOneOptions{})
 	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Wrapf(productErrors.ErrProductAlreadyExists, "InsertOne: %v", err)
+		}
 		return nil, errors.Wrap(err, "InsertOne")
 	}
 
@@ -56,7 +61,8 @@ func (p *productMongoRepo) Create(ctx context.Context, product *models.Product)
 	return product, nil
 }
 
-// Update Single product
+// Update Single active product, ErrProductNotFound is returned for unknown or archived product and
+// ErrVersionConflict if product version is set and does not match stored one
 func (p *productMongoRepo) Update(ctx context.Context, product *models.Product) (*models.Product, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Update")
 	defer span.Finish()
@@ -65,10 +71,86 @@ func (p *productMongoRepo) Update(ctx context.Context, product *models.Product)
 
 	ops := options.FindOneAndUpdate()
 	ops.SetReturnDocument(options.After)
+
+	f := bson.M{"_id": product.ProductID, "status": notArchived()}
+	update := getReplaceUpdate(product, time.Now().UTC())
+
+	var prod models.Product
+	if err := collection.FindOneAndUpdate(ctx, withVersion(f, product.Version), update, ops).Decode(&prod); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, p.getNotMatchedError(ctx, f, product.ProductID, product.Version)
+		}
+		return nil, errors.Wrap(err, "Decode")
+	}
+
+	return &prod, nil
+}
+
+// Upsert Create product with given id or replace all fields of active product, lifecycle status of existing product is kept.
+// ErrProductNotFound is returned for archived product
+func (p *productMongoRepo) Upsert(ctx context.Context, product *models.Product) (*models.Product, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Upsert")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	// mongo keeps milliseconds, truncated time is compared with stored one to tell inserted document
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	update := getFieldsUpdate(product, models.ProductReplaceFields)
+	update["$setOnInsert"] = bson.M{
+		"createdAt": createdAt,
+		"status":    models.ProductStatusActive,
+	}
+
+	ops := options.FindOneAndUpdate()
 	ops.SetUpsert(true)
+	ops.SetReturnDocument(options.After)
+
+	var prod models.Product
+	f := bson.M{"_id": product.ProductID, "status": notArchived()}
+	if err := collection.FindOneAndUpdate(ctx, f, update, ops).Decode(&prod); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, false, p.getUpsertConflictError(ctx, product.ProductID, err)
+		}
+		return nil, false, errors.Wrap(err, "Decode")
+	}
 
+	return &prod, prod.CreatedAt.Equal(createdAt), nil
+}
+
+// getUpsertConflictError Upsert filter does not match existing product only when it is archived,
+// otherwise product was created concurrently
+func (p *productMongoRepo) getUpsertConflictError(ctx context.Context, productID primitive.ObjectID, err error) error {
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	count, countErr := collection.CountDocuments(ctx, bson.M{"_id": productID, "status": models.ProductStatusArchived})
+	if countErr != nil {
+		return errors.Wrap(countErr, "CountDocuments")
+	}
+	if count > 0 {
+		return errors.Wrapf(productErrors.ErrProductNotFound, "product id: %s", productID.Hex())
+	}
+
+	return errors.Wrapf(productErrors.ErrProductAlreadyExists, "FindOneAndUpdate: %v", err)
+}
+
+// Patch Update only given fields of active product, ErrProductNotFound is returned for unknown or archived product and
+// ErrVersionConflict if product version is set and does not match stored one
+func (p *productMongoRepo) Patch(ctx context.Context, product *models.Product, fields []string) (*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Patch")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	ops := options.FindOneAndUpdate()
+	ops.SetReturnDocument(options.After)
+
+	f := bson.M{"_id": product.ProductID, "status": notArchived()}
 	var prod models.Product
-	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": product.ProductID}, bson.M{"$set": product}, ops).Decode(&prod); err != nil {
+	if err := collection.FindOneAndUpdate(ctx, withVersion(f, product.Version), getFieldsUpdate(product, fields), ops).Decode(&prod); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, p.getNotMatchedError(ctx, f, product.ProductID, product.Version)
+		}
 		return nil, errors.Wrap(err, "Decode")
 	}
 
@@ -83,78 +165,300 @@ func (p *productMongoRepo) GetByID(ctx context.Context, productID primitive.Obje
 	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
 
 	var prod models.Product
-	if err := collection.FindOne(ctx, bson.M{"_id": productID}).Decode(&prod); err != nil {
+	if err := collection.FindOne(ctx, bson.M{"_id": productID, "status": notArchived()}).Decode(&prod); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.Wrapf(productErrors.ErrProductNotFound, "product id: %s", productID.Hex())
+		}
 		return nil, errors.Wrap(err, "Decode")
 	}
 
 	return &prod, nil
 }
 
-// Search Search product
-func (p *productMongoRepo) Search(ctx context.Context, search string, pagination *utils.Pagination) (*models.ProductsList, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Search")
+// GetByIDs Get active products with given ids in single query, order of result is not defined
+func (p *productMongoRepo) GetByIDs(ctx context.Context, productIDs []primitive.ObjectID) ([]*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.GetByIDs")
 	defer span.Finish()
 
 	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
 
-	f := bson.D{
-		{Key: "$or", Value: bson.A{
-			bson.D{{Key: "name", Value: primitive.Regex{
-				Pattern: search,
-				Options: "gi",
-			}}},
-			bson.D{{Key: "description", Value: primitive.Regex{
-				Pattern: search,
-				Options: "gi",
-			}}},
-		}},
-	}
-
-	count, err := collection.CountDocuments(ctx, f)
-	if err != nil {
-		return nil, errors.Wrap(err, "CountDocuments")
-	}
-	if count == 0 {
-		return &models.ProductsList{
-			TotalCount: 0,
-			TotalPages: 0,
-			Page:       0,
-			Size:       0,
-			HasMore:    false,
-			Products:   make([]*models.Product, 0),
-		}, nil
-	}
-
-	limit := int64(pagination.GetLimit())
-	skip := int64(pagination.GetOffset())
-	cursor, err := collection.Find(ctx, f, &options.FindOptions{
-		Limit: &limit,
-		Skip:  &skip,
-	})
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": productIDs}, "status": notArchived()})
 	if err != nil {
 		return nil, errors.Wrap(err, "Find")
 	}
 	defer cursor.Close(ctx)
 
-	products := make([]*models.Product, 0, pagination.GetSize())
+	products := make([]*models.Product, 0, len(productIDs))
 	for cursor.Next(ctx) {
 		var prod models.Product
 		if err := cursor.Decode(&prod); err != nil {
-			return nil, errors.Wrap(err, "Find")
+			return nil, errors.Wrap(err, "Decode")
 		}
 		products = append(products, &prod)
 	}
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "cursor.Err")
+	}
+
+	return products, nil
+}
+
+// Search Search product
+func (p *productMongoRepo) Search(ctx context.Context, search string, filter *models.ProductsFilter, pagination *utils.Pagination) (*models.ProductsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Search")
+	defer span.Finish()
+
+	text := escapeTextSearch(search)
+	f := getSearchFilter(text, filter)
+
+	sort, err := getSort(pagination, text != "")
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find()
+	if text != "" {
+		opts.SetProjection(bson.M{"score": textScore()})
+	}
+
+	return p.findPage(ctx, f, sort, opts, pagination)
+}
 
+// Facets Count products matching search per category, price and rating buckets, only requested facets are calculated
+func (p *productMongoRepo) Facets(ctx context.Context, search string, filter *models.ProductsFilter) (*models.ProductFacets, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Facets")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: getSearchFilter(escapeTextSearch(search), filter)}},
+		{{Key: "$facet", Value: getFacetStages(filter)}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errors.Wrap(err, "Aggregate")
+	}
+	defer cursor.Close(ctx)
+
+	var res facetsResult
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&res); err != nil {
+			return nil, errors.Wrap(err, "Decode")
+		}
+	}
 	if err := cursor.Err(); err != nil {
 		return nil, errors.Wrap(err, "cursor.Err")
 	}
 
-	return &models.ProductsList{
-		TotalCount: count,
-		TotalPages: int64(pagination.GetTotalPages(int(count))),
-		Page:       int64(pagination.GetPage()),
-		Size:       int64(pagination.GetSize()),
-		HasMore:    pagination.GetHasMore(int(count)),
-		Products:   products,
-	}, nil
+	return res.toFacets(filter), nil
+}
+
+// ListByCategory Get products of categories
+func (p *productMongoRepo) ListByCategory(ctx context.Context, categoryIDs []primitive.ObjectID, pagination *utils.Pagination) (*models.ProductsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.ListByCategory")
+	defer span.Finish()
+
+	f := bson.D{
+		{Key: "categoryId", Value: bson.M{"$in": categoryIDs}},
+		{Key: "status", Value: notArchived()},
+	}
+
+	return p.findPage(ctx, f, bson.D{{Key: "_id", Value: -1}}, options.Find(), pagination)
+}
+
+// Suggest Get names of active products starting with lower case prefix, ordered by name
+func (p *productMongoRepo) Suggest(ctx context.Context, namePrefix string, limit int) ([]*models.ProductSuggestion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Suggest")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	// anchored case sensitive regex is a bounded range scan of nameLower index
+	f := bson.D{
+		{Key: "nameLower", Value: primitive.Regex{Pattern: "^" + regexp.QuoteMeta(namePrefix)}},
+		{Key: "status", Value: notArchived()},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"name": 1}).
+		SetSort(bson.D{{Key: "nameLower", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := collection.Find(ctx, f, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "Find")
+	}
+	defer cursor.Close(ctx)
+
+	suggestions := make([]*models.ProductSuggestion, 0, limit)
+	if err := cursor.All(ctx, &suggestions); err != nil {
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return suggestions, nil
+}
+
+// Archive Soft delete single product by id, ErrProductNotFound is returned for unknown or already archived product
+func (p *productMongoRepo) Archive(ctx context.Context, productID primitive.ObjectID) (*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Archive")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	ops := options.FindOneAndUpdate()
+	ops.SetReturnDocument(options.After)
+
+	now := time.Now().UTC()
+	update := bson.M{
+		"$set": bson.M{"status": models.ProductStatusArchived, "deletedAt": now, "updatedAt": now},
+		"$inc": bson.M{"version": 1},
+	}
+
+	var prod models.Product
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": productID, "status": notArchived()}, update, ops).Decode(&prod); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.Wrapf(productErrors.ErrProductNotFound, "product id: %s", productID.Hex())
+		}
+		return nil, errors.Wrap(err, "Decode")
+	}
+
+	return &prod, nil
+}
+
+// Restore Restore single archived product by id, ErrProductNotFound is returned for unknown or not archived product
+func (p *productMongoRepo) Restore(ctx context.Context, productID primitive.ObjectID) (*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productMongoRepo.Restore")
+	defer span.Finish()
+
+	collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+
+	ops := options.FindOneAndUpdate()
+	ops.SetReturnDocument(options.After)
+
+	update := bson.M{
+		"$set":   bson.M{"status": models.ProductStatusActive, "updatedAt": time.Now().UTC()},
+		"$unset": bson.M{"deletedAt": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+
+	var prod models.Product
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": productID, "status": models.ProductStatusArchived}, update, ops).Decode(&prod); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.Wrapf(productErrors.ErrProductNotFound, "product id: %s", productID.Hex())
+		}
+		return nil, errors.Wrap(err, "Decode")
+	}
+
+	return &prod, nil
+}
+
+// getNotMatchedError Tell apart missing product from version conflict when compare and set update matched nothing
+func (p *productMongoRepo) getNotMatchedError(ctx context.Context, f bson.M, productID primitive.ObjectID, expectedVersion int64) error {
+	if expectedVersion > 0 {
+		collection := p.mongoDB.Database(productsDB).Collection(productsCollection)
+		count, err := collection.CountDocuments(ctx, f, options.Count().SetLimit(1))
+		if err != nil {
+			return errors.Wrap(err, "CountDocuments")
+		}
+		if count > 0 {
+			return errors.Wrapf(productErrors.ErrVersionConflict, "product id: %s, expected version: %d", productID.Hex(), expectedVersion)
+		}
+	}
+	return errors.Wrapf(productErrors.ErrProductNotFound, "product id: %s", productID.Hex())
+}
+
+// withVersion Add expected version to filter, zero version matches any
+func withVersion(f bson.M, version int64) bson.M {
+	if version == 0 {
+		return f
+	}
+	versioned := bson.M{"version": version}
+	for k, v := range f {
+		versioned[k] = v
+	}
+	return versioned
+}
+
+// notArchived matches active products, including ones stored before status was introduced
+func notArchived() bson.M {
+	return bson.M{"$ne": models.ProductStatusArchived}
+}
+
+func isDuplicateKeyError(err error) bool {
+	// findAndModify reports duplicate key as command error
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) {
+		return commandErr.Code == duplicateKeyErrorCode
+	}
+
+	var writeException mongo.WriteException
+	if !errors.As(err, &writeException) {
+		return false
+	}
+	for _, writeErr := range writeException.WriteErrors {
+		if writeErr.Code == duplicateKeyErrorCode {
+			return true
+		}
+	}
+	return false
+}
+
+// setCreateFields Set fields maintained by repository of new product
+func setCreateFields(product *models.Product, now time.Time) {
+	product.CreatedAt = now
+	product.UpdatedAt = now
+	product.Status = models.ProductStatusActive
+	product.DeletedAt = nil
+	product.Version = 1
+}
+
+// getReplaceUpdate Build update replacing all product fields, lifecycle fields are changed only by Archive and Restore
+func getReplaceUpdate(product *models.Product, now time.Time) bson.M {
+	product.Status = ""
+	product.DeletedAt = nil
+	product.CreatedAt = time.Time{}
+	product.UpdatedAt = now
+
+	// version is only incremented, expected one is part of the filter
+	set := *product
+	set.Version = 0
+
+	return bson.M{"$set": &set, "$inc": bson.M{"version": 1}}
+}
+
+// getFieldsUpdate Build update of given fields, zero values are written and empty category, image or photos are removed
+func getFieldsUpdate(product *models.Product, fields []string) bson.M {
+	set := bson.M{"updatedAt": time.Now().UTC()}
+	unset := bson.M{}
+	for _, field := range fields {
+		value := product.FieldValue(field)
+		if isEmptyPatchValue(value) {
+			unset[field] = ""
+			continue
+		}
+		set[field] = value
+	}
+	if _, ok := set[models.ProductFieldName]; ok {
+		set["nameLower"] = product.GetNameLower()
+	}
+
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	return update
+}
+
+func isEmptyPatchValue(value interface{}) bool {
+	switch v := value.(type) {
+	case primitive.ObjectID:
+		return v.IsZero()
+	case string:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	default:
+		return false
+	}
 }
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/AleksK1NG/products-microservice/internal/models"
+)
+
+func TestGetFieldsUpdate(t *testing.T) {
+	categoryID := primitive.NewObjectID()
+	image := "https://example.com/image.png"
+
+	tests := []struct {
+		name    string
+		product *models.Product
+		fields  []string
+		set     bson.M
+		unset   bson.M
+	}{
+		{
+			name:    "name keeps lower case name in sync",
+			product: &models.Product{Name: "Hello World"},
+			fields:  []string{models.ProductFieldName},
+			set:     bson.M{"name": "Hello World", "nameLower": "hello world"},
+		},
+		{
+			name:    "zero numbers are written",
+			product: &models.Product{},
+			fields:  []string{models.ProductFieldPrice, models.ProductFieldQuantity, models.ProductFieldRating},
+			set:     bson.M{"price": 0.0, "quantity": int64(0), "rating": 0},
+		},
+		{
+			name:    "empty category, image and photos are removed",
+			product: &models.Product{Photos: []string{}},
+			fields:  []string{models.ProductFieldCategoryID, models.ProductFieldImageURL, models.ProductFieldPhotos},
+			set:     bson.M{},
+			unset:   bson.M{"categoryId": "", "imageUrl": "", "photos": ""},
+		},
+		{
+			name:    "only given fields are written",
+			product: &models.Product{CategoryID: categoryID, ImageURL: &image, Name: "ignored"},
+			fields:  []string{models.ProductFieldCategoryID, models.ProductFieldImageURL},
+			set:     bson.M{"categoryId": categoryID, "imageUrl": image},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := getFieldsUpdate(tt.product, tt.fields)
+
+			set, ok := update["$set"].(bson.M)
+			if !ok {
+				t.Fatalf("$set = %v", update["$set"])
+			}
+			if _, ok := set["updatedAt"]; !ok {
+				t.Errorf("updatedAt is not set")
+			}
+			delete(set, "updatedAt")
+			if !reflect.DeepEqual(set, tt.set) {
+				t.Errorf("$set = %v, want %v", set, tt.set)
+			}
+
+			unset, _ := update["$unset"].(bson.M)
+			if len(unset) != len(tt.unset) || (len(tt.unset) > 0 && !reflect.DeepEqual(unset, tt.unset)) {
+				t.Errorf("$unset = %v, want %v", unset, tt.unset)
+			}
+
+			if !reflect.DeepEqual(update["$inc"], bson.M{"version": 1}) {
+				t.Errorf("$inc = %v, want version increment", update["$inc"])
+			}
+		})
+	}
+}
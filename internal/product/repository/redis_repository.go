@@ -15,8 +15,10 @@ import (
 )
 
 const (
-	prefix     = "products"
-	expiration = time.Second * 3600
+	prefix                = "products"
+	expiration            = time.Second * 3600
+	suggestionsExpiration = time.Second * 60
+	idempotencyExpiration = time.Hour * 24
 )
 
 type productRedisRepository struct {
@@ -57,6 +59,56 @@ func (p *productRedisRepository) GetProductByID(ctx context.Context, productID p
 	return &res, nil
 }
 
+// GetProductsByIDs Get cached products in order of ids, missing products are nil
+func (p *productRedisRepository) GetProductsByIDs(ctx context.Context, productIDs []primitive.ObjectID) ([]*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.GetProductsByIDs")
+	defer span.Finish()
+
+	keys := make([]string, 0, len(productIDs))
+	for _, id := range productIDs {
+		keys = append(keys, p.createKey(id))
+	}
+
+	values, err := p.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "productRedisRepository.redis.MGet")
+	}
+
+	products := make([]*models.Product, len(values))
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var prod models.Product
+		if err := json.Unmarshal([]byte(str), &prod); err != nil {
+			return nil, errors.Wrap(err, "json.Unmarshal")
+		}
+		products[i] = &prod
+	}
+	return products, nil
+}
+
+// SetProducts Cache products in single pipeline
+func (p *productRedisRepository) SetProducts(ctx context.Context, products []*models.Product) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.SetProducts")
+	defer span.Finish()
+
+	pipe := p.redis.Pipeline()
+	for _, product := range products {
+		prodBytes, err := json.Marshal(product)
+		if err != nil {
+			return errors.Wrap(err, "productRedisRepository.Marshal")
+		}
+		pipe.SetEX(ctx, p.createKey(product.ProductID), string(prodBytes), expiration)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "productRedisRepository.pipe.Exec")
+	}
+	return nil
+}
+
 func (p *productRedisRepository) DeleteProduct(ctx context.Context, productID primitive.ObjectID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.DeleteProduct")
 	defer span.Finish()
@@ -64,6 +116,146 @@ func (p *productRedisRepository) DeleteProduct(ctx context.Context, productID pr
 	return p.redis.Del(ctx, p.createKey(productID)).Err()
 }
 
+// GetSuggestionsVersion Get version of cached suggestions, suggestions cached with older version are not used
+func (p *productRedisRepository) GetSuggestionsVersion(ctx context.Context) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.GetSuggestionsVersion")
+	defer span.Finish()
+
+	version, err := p.redis.Get(ctx, p.createSuggestionsVersionKey()).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, errors.Wrap(err, "productRedisRepository.redis.Get")
+	}
+	return version, nil
+}
+
+// InvalidateSuggestions Increment version of cached suggestions, cached ones expire by their TTL
+func (p *productRedisRepository) InvalidateSuggestions(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.InvalidateSuggestions")
+	defer span.Finish()
+
+	return p.redis.Incr(ctx, p.createSuggestionsVersionKey()).Err()
+}
+
+func (p *productRedisRepository) SetSuggestions(
+	ctx context.Context,
+	version int64,
+	query string,
+	limit int,
+	suggestions []*models.ProductSuggestion,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.SetSuggestions")
+	defer span.Finish()
+
+	suggestionsBytes, err := json.Marshal(suggestions)
+	if err != nil {
+		return errors.Wrap(err, "productRedisRepository.Marshal")
+	}
+
+	return p.redis.SetEX(ctx, p.createSuggestionsKey(version, query, limit), string(suggestionsBytes), suggestionsExpiration).Err()
+}
+
+func (p *productRedisRepository) GetSuggestions(ctx context.Context, version int64, query string, limit int) ([]*models.ProductSuggestion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.GetSuggestions")
+	defer span.Finish()
+
+	result, err := p.redis.Get(ctx, p.createSuggestionsKey(version, query, limit)).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "productRedisRepository.redis.Get")
+	}
+
+	var res []*models.ProductSuggestion
+	if err := json.Unmarshal(result, &res); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+	return res, nil
+}
+
+// ReserveIdempotencyKey Store record if key is not used yet, otherwise return record stored by first request
+func (p *productRedisRepository) ReserveIdempotencyKey(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.ReserveIdempotencyKey")
+	defer span.Finish()
+
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "productRedisRepository.Marshal")
+	}
+
+	reserved, err := p.redis.SetNX(ctx, p.createIdempotencyKey(record.Key), string(recordBytes), idempotencyExpiration).Result()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "productRedisRepository.redis.SetNX")
+	}
+	if reserved {
+		return record, true, nil
+	}
+
+	result, err := p.redis.Get(ctx, p.createIdempotencyKey(record.Key)).Bytes()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "productRedisRepository.redis.Get")
+	}
+
+	var res models.IdempotencyRecord
+	if err := json.Unmarshal(result, &res); err != nil {
+		return nil, false, errors.Wrap(err, "json.Unmarshal")
+	}
+	return &res, false, nil
+}
+
+func (p *productRedisRepository) SetIdempotencyRecord(ctx context.Context, record *models.IdempotencyRecord) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.SetIdempotencyRecord")
+	defer span.Finish()
+
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "productRedisRepository.Marshal")
+	}
+
+	return p.redis.SetEX(ctx, p.createIdempotencyKey(record.Key), string(recordBytes), idempotencyExpiration).Err()
+}
+
+// DeleteIdempotencyRecord Release key only if it is still reserved for given product,
+// key reserved again by retried request is kept
+func (p *productRedisRepository) DeleteIdempotencyRecord(ctx context.Context, key string, productID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productRedisRepository.DeleteIdempotencyRecord")
+	defer span.Finish()
+
+	redisKey := p.createIdempotencyKey(key)
+	return p.redis.Watch(ctx, func(tx *redis.Tx) error {
+		result, err := tx.Get(ctx, redisKey).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return nil
+			}
+			return errors.Wrap(err, "productRedisRepository.redis.Get")
+		}
+
+		var record models.IdempotencyRecord
+		if err := json.Unmarshal(result, &record); err != nil {
+			return errors.Wrap(err, "json.Unmarshal")
+		}
+		if record.ProductID != productID {
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, redisKey)
+			return nil
+		})
+		return err
+	}, redisKey)
+}
+
 func (p *productRedisRepository) createKey(id primitive.ObjectID) string {
 	return fmt.Sprintf("%s: %s", p.prefix, id.String())
 }
+
+func (p *productRedisRepository) createSuggestionsKey(version int64, query string, limit int) string {
+	return fmt.Sprintf("%s: suggest: %d: %d: %s", p.prefix, version, limit, query)
+}
+
+func (p *productRedisRepository) createSuggestionsVersionKey() string {
+	return fmt.Sprintf("%s: suggest: version", p.prefix)
+}
+
+func (p *productRedisRepository) createIdempotencyKey(key string) string {
+	return fmt.Sprintf("%s: idempotency: %s", p.prefix, key)
+}
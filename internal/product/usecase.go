@@ -13,8 +13,20 @@ import (
 type UseCase interface {
 	Create(ctx context.Context, product *models.Product) (*models.Product, error)
 	Update(ctx context.Context, product *models.Product) (*models.Product, error)
+	Patch(ctx context.Context, product *models.Product, fields []string) (*models.Product, error)
+	Upsert(ctx context.Context, product *models.Product) (*models.Product, bool, error)
+	BulkCreate(ctx context.Context, products []*models.Product) (*models.BulkResult, error)
+	BulkUpdate(ctx context.Context, products []*models.Product) (*models.BulkResult, error)
 	GetByID(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
-	Search(ctx context.Context, search string, pagination *utils.Pagination) (*models.ProductsList, error)
-	PublishCreate(ctx context.Context, product *models.Product) error
-	PublishUpdate(ctx context.Context, product *models.Product) error
+	GetByIDs(ctx context.Context, productIDs []primitive.ObjectID) (*models.ProductsBatch, error)
+	Search(ctx context.Context, search string, filter *models.ProductsFilter, pagination *utils.Pagination) (*models.ProductsList, error)
+	ListByCategory(ctx context.Context, categoryID primitive.ObjectID, includeDescendants bool, pagination *utils.Pagination) (*models.ProductsList, error)
+	Suggest(ctx context.Context, query string, limit int) ([]*models.ProductSuggestion, error)
+	Delete(ctx context.Context, productID primitive.ObjectID) error
+	Archive(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
+	Restore(ctx context.Context, productID primitive.ObjectID) (*models.Product, error)
+	PublishCreate(ctx context.Context, product *models.Product) (*models.Operation, error)
+	PublishUpdate(ctx context.Context, product *models.Product) (*models.Operation, error)
+	PublishDelete(ctx context.Context, productID primitive.ObjectID) (*models.Operation, error)
+	ReleaseIdempotencyKey(ctx context.Context, key string, productID primitive.ObjectID) error
 }
@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -11,10 +11,14 @@ import (
 	"github.com/segmentio/kafka-go"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/AleksK1NG/products-microservice/internal/category"
 	"github.com/AleksK1NG/products-microservice/internal/models"
+	"github.com/AleksK1NG/products-microservice/internal/operation"
 	"github.com/AleksK1NG/products-microservice/internal/product"
 	prodKafka "github.com/AleksK1NG/products-microservice/internal/product/delivery/kafka"
+	categoryErrors "github.com/AleksK1NG/products-microservice/pkg/category_errors"
 	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
 	"github.com/AleksK1NG/products-microservice/pkg/utils"
 )
 
@@ -22,6 +26,8 @@ import (
 type productUC struct {
 	productRepo  product.MongoRepository
 	redisRepo    product.RedisRepository
+	categoryUC   category.UseCase
+	operationUC  operation.UseCase
 	log          logger.Logger
 	prodProducer prodKafka.ProductsProducer
 }
@@ -30,17 +36,61 @@ type productUC struct {
 func NewProductUC(
 	productRepo product.MongoRepository,
 	redisRepo product.RedisRepository,
+	categoryUC category.UseCase,
+	operationUC operation.UseCase,
 	log logger.Logger,
 	prodProducer prodKafka.ProductsProducer,
 ) *productUC {
-	return &productUC{productRepo: productRepo, redisRepo: redisRepo, log: log, prodProducer: prodProducer}
+	return &productUC{
+		productRepo:  productRepo,
+		redisRepo:    redisRepo,
+		categoryUC:   categoryUC,
+		operationUC:  operationUC,
+		log:          log,
+		prodProducer: prodProducer,
+	}
 }
 
 // Create Create new product
 func (p *productUC) Create(ctx context.Context, product *models.Product) (*models.Product, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Create")
 	defer span.Finish()
-	return p.productRepo.Create(ctx, product)
+
+	if err := p.validateCategory(ctx, product.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if product.IdempotencyKey == "" {
+		created, err := p.productRepo.Create(ctx, product)
+		if err != nil {
+			return nil, err
+		}
+		p.invalidateSuggestions(ctx)
+		return created, nil
+	}
+
+	if product.ProductID.IsZero() {
+		product.ProductID = primitive.NewObjectID()
+	}
+	record, reserved, err := p.reserveIdempotencyKey(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	// replayed request returns original product, message with the same product id is inserted as usual
+	if !reserved && record.ProductID != product.ProductID {
+		return p.getIdempotentProduct(ctx, record)
+	}
+
+	created, err := p.productRepo.Create(ctx, product)
+	if err != nil {
+		if reserved {
+			p.deleteIdempotencyRecord(ctx, product.IdempotencyKey, product.ProductID)
+		}
+		return nil, err
+	}
+
+	p.invalidateSuggestions(ctx)
+	return created, nil
 }
 
 // Update single product
@@ -48,10 +98,46 @@ func (p *productUC) Update(ctx context.Context, product *models.Product) (*model
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Update")
 	defer span.Finish()
 
+	if err := p.validateCategory(ctx, product.CategoryID); err != nil {
+		return nil, err
+	}
+
 	prod, err := p.productRepo.Update(ctx, product)
 	if err != nil {
 		return nil, errors.Wrap(err, "Update")
 	}
+	p.invalidateSuggestions(ctx)
+
+	if err := p.redisRepo.SetProduct(ctx, prod); err != nil {
+		p.log.Errorf("redisRepo.SetProduct: %v", err)
+	}
+
+	return prod, nil
+}
+
+// Patch Update only given fields of product
+func (p *productUC) Patch(ctx context.Context, product *models.Product, fields []string) (*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Patch")
+	defer span.Finish()
+
+	for _, field := range fields {
+		if field == models.ProductFieldCategoryID {
+			if err := p.validateCategory(ctx, product.CategoryID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	prod, err := p.productRepo.Patch(ctx, product, fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "Patch")
+	}
+	for _, field := range fields {
+		if field == models.ProductFieldName {
+			p.invalidateSuggestions(ctx)
+			break
+		}
+	}
 
 	if err := p.redisRepo.SetProduct(ctx, prod); err != nil {
 		p.log.Errorf("redisRepo.SetProduct: %v", err)
@@ -60,6 +146,91 @@ func (p *productUC) Update(ctx context.Context, product *models.Product) (*model
 	return prod, nil
 }
 
+// Upsert Create product with given id or replace it, created is true when product did not exist
+func (p *productUC) Upsert(ctx context.Context, product *models.Product) (*models.Product, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Upsert")
+	defer span.Finish()
+
+	if err := p.validateCategory(ctx, product.CategoryID); err != nil {
+		return nil, false, err
+	}
+
+	prod, created, err := p.productRepo.Upsert(ctx, product)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "Upsert")
+	}
+	p.invalidateSuggestions(ctx)
+
+	if err := p.redisRepo.SetProduct(ctx, prod); err != nil {
+		p.log.Errorf("redisRepo.SetProduct: %v", err)
+	}
+
+	return prod, created, nil
+}
+
+// BulkCreate Create products, result has status of every product in request order,
+// product with idempotency key already used reports product created by the first request
+func (p *productUC) BulkCreate(ctx context.Context, products []*models.Product) (*models.BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.BulkCreate")
+	defer span.Finish()
+
+	result, valid, indexes, err := p.validateBulk(ctx, products, nil)
+	if err != nil || len(valid) == 0 {
+		return result, err
+	}
+
+	valid, indexes = p.reserveBulkIdempotencyKeys(ctx, result, valid, indexes)
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	created, err := p.productRepo.BulkCreate(ctx, valid)
+	if err != nil {
+		p.releaseBulkIdempotencyKeys(ctx, valid, nil)
+		return nil, errors.Wrap(err, "BulkCreate")
+	}
+	result.Merge(indexes, created)
+	p.releaseBulkIdempotencyKeys(ctx, valid, created)
+
+	if created.Succeeded > 0 {
+		p.invalidateSuggestions(ctx)
+	}
+
+	return result, nil
+}
+
+// BulkUpdate Update products, result has status of every product in request order
+func (p *productUC) BulkUpdate(ctx context.Context, products []*models.Product) (*models.BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.BulkUpdate")
+	defer span.Finish()
+
+	result, valid, indexes, err := p.validateBulk(ctx, products, models.ValidateBulkProductIDs(products))
+	if err != nil || len(valid) == 0 {
+		return result, err
+	}
+
+	updated, err := p.productRepo.BulkUpdate(ctx, valid)
+	if err != nil {
+		return nil, errors.Wrap(err, "BulkUpdate")
+	}
+	result.Merge(indexes, updated)
+
+	if updated.Succeeded > 0 {
+		p.invalidateSuggestions(ctx)
+	}
+
+	for _, item := range updated.Items {
+		if !item.Success {
+			continue
+		}
+		if err := p.redisRepo.DeleteProduct(ctx, item.ProductID); err != nil {
+			p.log.Errorf("redisRepo.DeleteProduct: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
 // GetByID Get single product by id
 func (p *productUC) GetByID(ctx context.Context, productID primitive.ObjectID) (*models.Product, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.GetByID")
@@ -85,41 +256,501 @@ func (p *productUC) GetByID(ctx context.Context, productID primitive.ObjectID) (
 	return prod, nil
 }
 
+// GetByIDs Get products in request order, cached products are read from redis and the rest from mongo in single query
+func (p *productUC) GetByIDs(ctx context.Context, productIDs []primitive.ObjectID) (*models.ProductsBatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.GetByIDs")
+	defer span.Finish()
+
+	if err := models.ValidateBulkSize(len(productIDs)); err != nil {
+		return nil, err
+	}
+
+	unique := make([]primitive.ObjectID, 0, len(productIDs))
+	found := make(map[primitive.ObjectID]*models.Product, len(productIDs))
+	for _, id := range productIDs {
+		if _, ok := found[id]; !ok {
+			found[id] = nil
+			unique = append(unique, id)
+		}
+	}
+
+	cached, err := p.redisRepo.GetProductsByIDs(ctx, unique)
+	if err != nil {
+		p.log.Errorf("redisRepo.GetProductsByIDs: %v", err)
+		cached = make([]*models.Product, len(unique))
+	}
+
+	misses := make([]primitive.ObjectID, 0, len(unique))
+	for i, id := range unique {
+		if cached[i] != nil {
+			found[id] = cached[i]
+			continue
+		}
+		misses = append(misses, id)
+	}
+
+	if len(misses) > 0 {
+		products, err := p.productRepo.GetByIDs(ctx, misses)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetByIDs")
+		}
+		for _, prod := range products {
+			found[prod.ProductID] = prod
+		}
+
+		if len(products) > 0 {
+			if err := p.redisRepo.SetProducts(ctx, products); err != nil {
+				p.log.Errorf("redisRepo.SetProducts: %v", err)
+			}
+		}
+	}
+
+	batch := &models.ProductsBatch{
+		Products: make([]*models.Product, 0, len(productIDs)),
+		NotFound: make([]primitive.ObjectID, 0),
+	}
+	for _, id := range productIDs {
+		if prod := found[id]; prod != nil {
+			batch.Products = append(batch.Products, prod)
+			continue
+		}
+		batch.NotFound = append(batch.NotFound, id)
+	}
+
+	return batch, nil
+}
+
 // Search Search products
-func (p *productUC) Search(ctx context.Context, search string, pagination *utils.Pagination) (*models.ProductsList, error) {
+func (p *productUC) Search(ctx context.Context, search string, filter *models.ProductsFilter, pagination *utils.Pagination) (*models.ProductsList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Search")
 	defer span.Finish()
-	return p.productRepo.Search(ctx, search, pagination)
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	productsList, err := p.productRepo.Search(ctx, search, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filter.Facets) > 0 {
+		facets, err := p.productRepo.Facets(ctx, search, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "productRepo.Facets")
+		}
+		productsList.Facets = facets
+	}
+
+	return productsList, nil
+}
+
+// ListByCategory Get products of category, optionally including all descendant categories
+func (p *productUC) ListByCategory(
+	ctx context.Context,
+	categoryID primitive.ObjectID,
+	includeDescendants bool,
+	pagination *utils.Pagination,
+) (*models.ProductsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.ListByCategory")
+	defer span.Finish()
+
+	if _, err := p.categoryUC.GetByID(ctx, categoryID); err != nil {
+		return nil, errors.Wrap(err, "categoryUC.GetByID")
+	}
+
+	categoryIDs := []primitive.ObjectID{categoryID}
+	if includeDescendants {
+		ids, err := p.categoryUC.GetSubtreeIDs(ctx, categoryID)
+		if err != nil {
+			return nil, errors.Wrap(err, "categoryUC.GetSubtreeIDs")
+		}
+		categoryIDs = ids
+	}
+
+	return p.productRepo.ListByCategory(ctx, categoryIDs, pagination)
 }
 
-// PublishCreate create new product
-func (p *productUC) PublishCreate(ctx context.Context, product *models.Product) error {
+// Suggest Get product names starting with query for typeahead, results are cached until product names or lifecycle change
+func (p *productUC) Suggest(ctx context.Context, query string, limit int) ([]*models.ProductSuggestion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Suggest")
+	defer span.Finish()
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return []*models.ProductSuggestion{}, nil
+	}
+	if limit <= 0 {
+		limit = models.SuggestDefaultLimit
+	}
+	if limit > models.SuggestMaxLimit {
+		limit = models.SuggestMaxLimit
+	}
+
+	// version is read before query, so suggestions are not cached under version of write they do not see
+	version, err := p.redisRepo.GetSuggestionsVersion(ctx)
+	if err != nil {
+		p.log.Errorf("redisRepo.GetSuggestionsVersion: %v", err)
+		return p.productRepo.Suggest(ctx, query, limit)
+	}
+
+	cached, err := p.redisRepo.GetSuggestions(ctx, version, query, limit)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		p.log.Errorf("redisRepo.GetSuggestions: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	suggestions, err := p.productRepo.Suggest(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "productRepo.Suggest")
+	}
+
+	if err := p.redisRepo.SetSuggestions(ctx, version, query, limit, suggestions); err != nil {
+		p.log.Errorf("redisRepo.SetSuggestions: %v", err)
+	}
+
+	return suggestions, nil
+}
+
+// Delete Soft delete single product by id, product is archived and can be restored
+func (p *productUC) Delete(ctx context.Context, productID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Delete")
+	defer span.Finish()
+
+	if _, err := p.Archive(ctx, productID); err != nil {
+		return errors.Wrap(err, "Delete")
+	}
+
+	return nil
+}
+
+// Archive Archive single product by id
+func (p *productUC) Archive(ctx context.Context, productID primitive.ObjectID) (*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Archive")
+	defer span.Finish()
+
+	prod, err := p.productRepo.Archive(ctx, productID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Archive")
+	}
+	p.invalidateSuggestions(ctx)
+
+	if err := p.redisRepo.DeleteProduct(ctx, productID); err != nil {
+		p.log.Errorf("redisRepo.DeleteProduct: %v", err)
+	}
+
+	return prod, nil
+}
+
+// Restore Restore single archived product by id
+func (p *productUC) Restore(ctx context.Context, productID primitive.ObjectID) (*models.Product, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.Restore")
+	defer span.Finish()
+
+	prod, err := p.productRepo.Restore(ctx, productID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Restore")
+	}
+	p.invalidateSuggestions(ctx)
+
+	if err := p.redisRepo.SetProduct(ctx, prod); err != nil {
+		p.log.Errorf("redisRepo.SetProduct: %v", err)
+	}
+
+	return prod, nil
+}
+
+// PublishCreate create new product, returned operation tracks consumer result
+func (p *productUC) PublishCreate(ctx context.Context, product *models.Product) (*models.Operation, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.PublishCreate")
 	defer span.Finish()
 
-	prodBytes, err := json.Marshal(&product)
+	if err := p.validateCategory(ctx, product.CategoryID); err != nil {
+		return nil, err
+	}
+
+	// id is assigned before publishing so the caller can find the product once it is consumed
+	product.ProductID = primitive.NewObjectID()
+
+	prodBytes, err := prodKafka.EncodeProduct(product)
 	if err != nil {
-		return errors.Wrap(err, "json.Marshal")
+		return nil, errors.Wrap(err, "EncodeProduct")
 	}
 
-	return p.prodProducer.PublishCreate(ctx, kafka.Message{
-		Value: prodBytes,
-		Time:  time.Now().UTC(),
-	})
+	if product.IdempotencyKey == "" {
+		return p.publishOperation(ctx, models.OperationTypeCreateProduct, product.ProductID, prodBytes, p.prodProducer.PublishCreate)
+	}
+
+	record, reserved, err := p.reserveIdempotencyKey(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	if !reserved {
+		return p.getIdempotentOperation(ctx, record)
+	}
+
+	op, err := p.publishOperation(
+		ctx,
+		models.OperationTypeCreateProduct,
+		product.ProductID,
+		prodBytes,
+		p.prodProducer.PublishCreate,
+		kafka.Header{Key: prodKafka.IdempotencyKeyHeader, Value: []byte(product.IdempotencyKey)},
+	)
+	if err != nil {
+		p.deleteIdempotencyRecord(ctx, product.IdempotencyKey, product.ProductID)
+		return nil, err
+	}
+
+	record.OperationID = op.OperationID
+	if err := p.redisRepo.SetIdempotencyRecord(ctx, record); err != nil {
+		p.log.Errorf("redisRepo.SetIdempotencyRecord: %v", err)
+	}
+
+	return op, nil
 }
 
-// PublishUpdate update new product
-func (p *productUC) PublishUpdate(ctx context.Context, product *models.Product) error {
+// PublishUpdate update new product, returned operation tracks consumer result,
+// stale expected version is rejected before publishing
+func (p *productUC) PublishUpdate(ctx context.Context, product *models.Product) (*models.Operation, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.PublishUpdate")
 	defer span.Finish()
 
-	prodBytes, err := json.Marshal(&product)
+	if err := p.validateCategory(ctx, product.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if err := p.validateVersion(ctx, product.ProductID, product.Version); err != nil {
+		return nil, err
+	}
+
+	prodBytes, err := prodKafka.EncodeProduct(product)
+	if err != nil {
+		return nil, errors.Wrap(err, "EncodeProduct")
+	}
+
+	return p.publishOperation(ctx, models.OperationTypeUpdateProduct, product.ProductID, prodBytes, p.prodProducer.PublishUpdate)
+}
+
+// PublishDelete delete product, returned operation tracks consumer result
+func (p *productUC) PublishDelete(ctx context.Context, productID primitive.ObjectID) (*models.Operation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.PublishDelete")
+	defer span.Finish()
+
+	msgBytes, err := prodKafka.EncodeDeleteProduct(productID)
+	if err != nil {
+		return nil, errors.Wrap(err, "EncodeDeleteProduct")
+	}
+
+	return p.publishOperation(ctx, models.OperationTypeDeleteProduct, productID, msgBytes, p.prodProducer.PublishDelete)
+}
+
+// validateVersion Check expected version against stored product, zero version matches any,
+// consumer still applies the update with version check so concurrent writes are rejected there
+func (p *productUC) validateVersion(ctx context.Context, productID primitive.ObjectID, version int64) error {
+	if version == 0 {
+		return nil
+	}
+
+	prod, err := p.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return errors.Wrap(err, "productRepo.GetByID")
+	}
+	if prod.Version != version {
+		return errors.Wrapf(productErrors.ErrVersionConflict, "product id: %s, expected version: %d", productID.Hex(), version)
+	}
+
+	return nil
+}
+
+// publishOperation Create pending operation and publish product event with operation id and event headers,
+// operation is failed if message can not be published
+func (p *productUC) publishOperation(
+	ctx context.Context,
+	operationType string,
+	productID primitive.ObjectID,
+	value []byte,
+	publish func(ctx context.Context, msgs ...kafka.Message) error,
+	headers ...kafka.Header,
+) (*models.Operation, error) {
+	op, err := p.operationUC.Create(ctx, operationType, productID)
+	if err != nil {
+		return nil, errors.Wrap(err, "operationUC.Create")
+	}
+
+	msgHeaders := append([]kafka.Header{{Key: prodKafka.OperationIDHeader, Value: []byte(op.OperationID)}}, prodKafka.EventHeaders()...)
+	if err := publish(ctx, kafka.Message{
+		Key:     []byte(productID.Hex()),
+		Value:   value,
+		Time:    time.Now().UTC(),
+		Headers: append(msgHeaders, headers...),
+	}); err != nil {
+		if err := p.operationUC.SetFailed(ctx, op.OperationID, err); err != nil {
+			p.log.Errorf("operationUC.SetFailed: %v", err)
+		}
+		return nil, errors.Wrap(err, "publish")
+	}
+
+	return op, nil
+}
+
+// ReleaseIdempotencyKey Release key of asynchronous create which failed, so request can be retried with the same key
+func (p *productUC) ReleaseIdempotencyKey(ctx context.Context, key string, productID primitive.ObjectID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "productUC.ReleaseIdempotencyKey")
+	defer span.Finish()
+
+	if err := p.redisRepo.DeleteIdempotencyRecord(ctx, key, productID); err != nil {
+		return errors.Wrap(err, "redisRepo.DeleteIdempotencyRecord")
+	}
+	return nil
+}
+
+// reserveIdempotencyKey Reserve key for product, key used by request with different product is rejected
+func (p *productUC) reserveIdempotencyKey(ctx context.Context, product *models.Product) (*models.IdempotencyRecord, bool, error) {
+	fingerprint, err := product.GetFingerprint()
 	if err != nil {
-		return errors.Wrap(err, "json.Marshal")
+		return nil, false, errors.Wrap(err, "GetFingerprint")
 	}
 
-	return p.prodProducer.PublishUpdate(ctx, kafka.Message{
-		Value: prodBytes,
-		Time:  time.Now().UTC(),
+	record, reserved, err := p.redisRepo.ReserveIdempotencyKey(ctx, &models.IdempotencyRecord{
+		Key:         product.IdempotencyKey,
+		ProductID:   product.ProductID,
+		Fingerprint: fingerprint,
+		CreatedAt:   time.Now().UTC(),
 	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "ReserveIdempotencyKey")
+	}
+	if !reserved && record.Fingerprint != fingerprint {
+		return nil, false, errors.Wrapf(productErrors.ErrIdempotencyKeyReused, "idempotency key: %s", record.Key)
+	}
+
+	return record, reserved, nil
+}
+
+// getIdempotentProduct Get product created by first request with the same idempotency key
+func (p *productUC) getIdempotentProduct(ctx context.Context, record *models.IdempotencyRecord) (*models.Product, error) {
+	prod, err := p.GetByID(ctx, record.ProductID)
+	if err != nil {
+		if errors.Is(err, productErrors.ErrProductNotFound) {
+			return nil, errors.Wrapf(productErrors.ErrIdempotencyInProgress, "idempotency key: %s", record.Key)
+		}
+		return nil, err
+	}
+	return prod, nil
+}
+
+// getIdempotentOperation Get operation published by first request with the same idempotency key
+func (p *productUC) getIdempotentOperation(ctx context.Context, record *models.IdempotencyRecord) (*models.Operation, error) {
+	if record.OperationID == "" {
+		return nil, errors.Wrapf(productErrors.ErrIdempotencyInProgress, "idempotency key: %s", record.Key)
+	}
+	return p.operationUC.GetByID(ctx, record.OperationID)
+}
+
+// deleteIdempotencyRecord Release idempotency key of failed request so it can be retried
+func (p *productUC) deleteIdempotencyRecord(ctx context.Context, key string, productID primitive.ObjectID) {
+	if err := p.redisRepo.DeleteIdempotencyRecord(ctx, key, productID); err != nil {
+		p.log.Errorf("redisRepo.DeleteIdempotencyRecord: %v", err)
+	}
+}
+
+// validateBulk Check categories of products, products with unknown category or with error in failed by index are failed
+// in result and the rest is returned with their indexes in request
+func (p *productUC) validateBulk(ctx context.Context, products []*models.Product, failed map[int]error) (*models.BulkResult, []*models.Product, []int, error) {
+	result := models.NewBulkResult(len(products))
+	valid := make([]*models.Product, 0, len(products))
+	indexes := make([]int, 0, len(products))
+	categories := make(map[primitive.ObjectID]error)
+	for i, product := range products {
+		if err, ok := failed[i]; ok {
+			result.SetFailed(i, product.ProductID, err)
+			continue
+		}
+
+		categoryErr, ok := categories[product.CategoryID]
+		if !ok {
+			categoryErr = p.validateCategory(ctx, product.CategoryID)
+			if categoryErr != nil && !errors.Is(categoryErr, categoryErrors.ErrCategoryNotFound) {
+				return nil, nil, nil, errors.Wrap(categoryErr, "validateCategory")
+			}
+			categories[product.CategoryID] = categoryErr
+		}
+
+		if categoryErr != nil {
+			result.SetFailed(i, product.ProductID, categoryErr)
+			continue
+		}
+		valid = append(valid, product)
+		indexes = append(indexes, i)
+	}
+
+	return result, valid, indexes, nil
+}
+
+// reserveBulkIdempotencyKeys Reserve idempotency keys of valid bulk products, product with key already used is reported
+// in result with product created by the first request and the rest is returned with their indexes in request
+func (p *productUC) reserveBulkIdempotencyKeys(
+	ctx context.Context,
+	result *models.BulkResult,
+	products []*models.Product,
+	indexes []int,
+) ([]*models.Product, []int) {
+	reservedProducts := make([]*models.Product, 0, len(products))
+	reservedIndexes := make([]int, 0, len(indexes))
+	for i, product := range products {
+		if product.IdempotencyKey == "" {
+			reservedProducts = append(reservedProducts, product)
+			reservedIndexes = append(reservedIndexes, indexes[i])
+			continue
+		}
+
+		if product.ProductID.IsZero() {
+			product.ProductID = primitive.NewObjectID()
+		}
+		record, reserved, err := p.reserveIdempotencyKey(ctx, product)
+		if err != nil {
+			result.SetFailed(indexes[i], product.ProductID, err)
+			continue
+		}
+		if !reserved {
+			if _, err := p.getIdempotentProduct(ctx, record); err != nil {
+				result.SetFailed(indexes[i], record.ProductID, err)
+				continue
+			}
+			result.SetSucceeded(indexes[i], record.ProductID)
+			continue
+		}
+
+		reservedProducts = append(reservedProducts, product)
+		reservedIndexes = append(reservedIndexes, indexes[i])
+	}
+	return reservedProducts, reservedIndexes
+}
+
+// releaseBulkIdempotencyKeys Release idempotency keys of products which were not created, nil result releases all keys
+func (p *productUC) releaseBulkIdempotencyKeys(ctx context.Context, products []*models.Product, result *models.BulkResult) {
+	for i, product := range products {
+		if product.IdempotencyKey == "" || (result != nil && result.Items[i].Success) {
+			continue
+		}
+		p.deleteIdempotencyRecord(ctx, product.IdempotencyKey, product.ProductID)
+	}
+}
+
+// invalidateSuggestions Drop cached suggestions after product names or lifecycle change
+func (p *productUC) invalidateSuggestions(ctx context.Context) {
+	if err := p.redisRepo.InvalidateSuggestions(ctx); err != nil {
+		p.log.Errorf("redisRepo.InvalidateSuggestions: %v", err)
+	}
+}
+
+// validateCategory product without category is allowed, otherwise category must exist
+func (p *productUC) validateCategory(ctx context.Context, categoryID primitive.ObjectID) error {
+	if categoryID.IsZero() {
+		return nil
+	}
+	return p.categoryUC.Exists(ctx, categoryID)
 }
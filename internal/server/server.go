@@ -26,14 +26,29 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/AleksK1NG/products-microservice/config"
+	category "github.com/AleksK1NG/products-microservice/internal/category/delivery/grpc"
+	categoriesHttpV1 "github.com/AleksK1NG/products-microservice/internal/category/delivery/http/v1"
+	categoryRepository "github.com/AleksK1NG/products-microservice/internal/category/repository"
+	categoryUseCase "github.com/AleksK1NG/products-microservice/internal/category/usecase"
+	deadLetter "github.com/AleksK1NG/products-microservice/internal/deadletter/delivery/grpc"
+	deadLettersHttpV1 "github.com/AleksK1NG/products-microservice/internal/deadletter/delivery/http/v1"
+	deadLetterRepository "github.com/AleksK1NG/products-microservice/internal/deadletter/repository"
+	deadLetterUseCase "github.com/AleksK1NG/products-microservice/internal/deadletter/usecase"
 	"github.com/AleksK1NG/products-microservice/internal/interceptors"
 	"github.com/AleksK1NG/products-microservice/internal/middlewares"
+	operation "github.com/AleksK1NG/products-microservice/internal/operation/delivery/grpc"
+	operationsHttpV1 "github.com/AleksK1NG/products-microservice/internal/operation/delivery/http/v1"
+	operationRepository "github.com/AleksK1NG/products-microservice/internal/operation/repository"
+	operationUseCase "github.com/AleksK1NG/products-microservice/internal/operation/usecase"
 	product "github.com/AleksK1NG/products-microservice/internal/product/delivery/grpc"
 	productsHttpV1 "github.com/AleksK1NG/products-microservice/internal/product/delivery/http/v1"
 	"github.com/AleksK1NG/products-microservice/internal/product/delivery/kafka"
 	"github.com/AleksK1NG/products-microservice/internal/product/repository"
 	"github.com/AleksK1NG/products-microservice/internal/product/usecase"
 	"github.com/AleksK1NG/products-microservice/pkg/logger"
+	categoriesService "github.com/AleksK1NG/products-microservice/proto/category"
+	deadLettersService "github.com/AleksK1NG/products-microservice/proto/deadletter"
+	operationsService "github.com/AleksK1NG/products-microservice/proto/operation"
 	productsService "github.com/AleksK1NG/products-microservice/proto/product"
 )
 
@@ -74,9 +89,19 @@ func (s *server) Run() error {
 	productsProducer.Run()
 	defer productsProducer.Close()
 
+	categoryMongoRepo := categoryRepository.NewCategoryMongoRepo(s.mongoDB)
+	categoryRedisRepo := categoryRepository.NewCategoryRedisRepository(s.redis)
+	categoryUC := categoryUseCase.NewCategoryUC(categoryMongoRepo, categoryRedisRepo, s.log)
+
+	operationRedisRepo := operationRepository.NewOperationRedisRepository(s.redis)
+	operationUC := operationUseCase.NewOperationUC(operationRedisRepo, s.log)
+
 	productMongoRepo := repository.NewProductMongoRepo(s.mongoDB)
 	productRedisRepo := repository.NewProductRedisRepository(s.redis)
-	productUC := usecase.NewProductUC(productMongoRepo, productRedisRepo, s.log, productsProducer)
+	productUC := usecase.NewProductUC(productMongoRepo, productRedisRepo, categoryUC, operationUC, s.log, productsProducer)
+
+	deadLetterKafkaRepo := deadLetterRepository.NewDeadLetterKafkaRepository(s.log, s.cfg)
+	deadLetterUC := deadLetterUseCase.NewDeadLetterUC(ctx, deadLetterKafkaRepo, productsProducer, operationUC, s.log)
 
 	im := interceptors.NewInterceptorManager(s.log, s.cfg)
 	mw := middlewares.NewMiddlewareManager(s.log, s.cfg)
@@ -106,20 +131,37 @@ func (s *server) Run() error {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpcrecovery.UnaryServerInterceptor(),
 			im.Logger,
+			im.AdminAuth,
 		),
 	)
 
 	productService := product.NewProductService(s.log, productUC, validate)
 	productsService.RegisterProductsServiceServer(grpcServer, productService)
+	categoryService := category.NewCategoryService(s.log, categoryUC, validate)
+	categoriesService.RegisterCategoriesServiceServer(grpcServer, categoryService)
+	operationService := operation.NewOperationService(s.log, operationUC)
+	operationsService.RegisterOperationsServiceServer(grpcServer, operationService)
+	deadLetterService := deadLetter.NewDeadLetterService(s.log, deadLetterUC)
+	deadLettersService.RegisterDeadLettersServiceServer(grpcServer, deadLetterService)
 	grpc_prometheus.Register(grpcServer)
 
 	v1 := s.echo.Group("/api/v1")
 	v1.Use(mw.Metrics)
 
-	productHandlers := productsHttpV1.NewProductHandlers(s.log, productUC, validate, v1.Group("/products"), mw)
+	productHandlers := productsHttpV1.NewProductHandlers(s.log, s.cfg, productUC, validate, v1.Group("/products"), mw)
 	productHandlers.MapRoutes()
 
-	productsCG := kafka.NewProductsConsumerGroup(s.cfg.Kafka.Brokers, kafkaGroupID, s.log, s.cfg, productUC, validate)
+	categoryHandlers := categoriesHttpV1.NewCategoryHandlers(s.log, categoryUC, productUC, validate, v1.Group("/categories"), mw)
+	categoryHandlers.MapRoutes()
+
+	operationHandlers := operationsHttpV1.NewOperationHandlers(s.log, operationUC, v1.Group("/operations"), mw)
+	operationHandlers.MapRoutes()
+
+	admin := v1.Group("/admin", mw.AdminAuth)
+	deadLetterHandlers := deadLettersHttpV1.NewDeadLetterHandlers(s.log, deadLetterUC, validate, admin.Group("/dead-letters"), mw)
+	deadLetterHandlers.MapRoutes()
+
+	productsCG := kafka.NewProductsConsumerGroup(s.cfg.Kafka.Brokers, kafkaGroupID, s.log, s.cfg, productUC, operationUC, validate)
 	productsCG.RunConsumers(ctx, cancel)
 
 	go func() {
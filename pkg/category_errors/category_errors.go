@@ -0,0 +1,9 @@
+package categoryErrors
+
+import "github.com/pkg/errors"
+
+var (
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryNotEmpty      = errors.New("category has child categories or products")
+	ErrInvalidParentCategory = errors.New("invalid parent category")
+)
@@ -0,0 +1,7 @@
+package deadLetterErrors
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidReplayFilter = errors.New("invalid replay filter")
+)
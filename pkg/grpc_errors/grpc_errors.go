@@ -8,15 +8,22 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	categoryErrors "github.com/AleksK1NG/products-microservice/pkg/category_errors"
+	deadLetterErrors "github.com/AleksK1NG/products-microservice/pkg/deadletter_errors"
+	operationErrors "github.com/AleksK1NG/products-microservice/pkg/operation_errors"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
 )
 
 var (
-	ErrNotFound         = errors.New("Not found")
-	ErrNoCtxMetaData    = errors.New("No ctx metadata")
-	ErrInvalidSessionId = errors.New("Invalid session id")
-	ErrEmailExists      = errors.New("Email already exists")
+	ErrNotFound          = errors.New("Not found")
+	ErrNoCtxMetaData     = errors.New("No ctx metadata")
+	ErrInvalidSessionId  = errors.New("Invalid session id")
+	ErrEmailExists       = errors.New("Email already exists")
+	ErrInvalidAdminToken = errors.New("Invalid admin token")
 )
 
 // ParseGRPCErrStatusCode Parse error and get code
@@ -24,14 +31,48 @@ func ParseGRPCErrStatusCode(err error) codes.Code {
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return codes.NotFound
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return codes.NotFound
+	case errors.Is(err, deadLetterErrors.ErrInvalidReplayFilter):
+		return codes.InvalidArgument
+	case errors.Is(err, operationErrors.ErrOperationNotFound):
+		return codes.NotFound
 	case errors.Is(err, context.Canceled):
 		return codes.Canceled
 	case errors.Is(err, context.DeadlineExceeded):
 		return codes.DeadlineExceeded
+	case errors.Is(err, productErrors.ErrProductNotFound):
+		return codes.NotFound
+	case errors.Is(err, productErrors.ErrVersionConflict):
+		return codes.Aborted
+	case errors.Is(err, productErrors.ErrInvalidOrderBy):
+		return codes.InvalidArgument
+	case errors.Is(err, productErrors.ErrInvalidFilter):
+		return codes.InvalidArgument
+	case errors.Is(err, productErrors.ErrInvalidCursor):
+		return codes.InvalidArgument
+	case errors.Is(err, productErrors.ErrInvalidFieldMask):
+		return codes.InvalidArgument
+	case errors.Is(err, productErrors.ErrInvalidBulkSize):
+		return codes.InvalidArgument
+	case errors.Is(err, productErrors.ErrProductAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, productErrors.ErrIdempotencyInProgress):
+		return codes.Aborted
+	case errors.Is(err, productErrors.ErrIdempotencyKeyReused):
+		return codes.FailedPrecondition
+	case errors.Is(err, categoryErrors.ErrCategoryNotFound):
+		return codes.InvalidArgument
+	case errors.Is(err, categoryErrors.ErrInvalidParentCategory):
+		return codes.InvalidArgument
+	case errors.Is(err, categoryErrors.ErrCategoryNotEmpty):
+		return codes.FailedPrecondition
 	case errors.Is(err, ErrEmailExists):
 		return codes.AlreadyExists
 	case errors.Is(err, ErrNoCtxMetaData):
 		return codes.Unauthenticated
+	case errors.Is(err, ErrInvalidAdminToken):
+		return codes.Unauthenticated
 	case errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
 	case strings.Contains(err.Error(), "Validate"):
@@ -65,6 +106,10 @@ func MapGRPCErrCodeToHttpStatus(code codes.Code) int {
 		return http.StatusGatewayTimeout
 	case codes.InvalidArgument:
 		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Aborted:
+		return http.StatusConflict
 	}
 	return http.StatusInternalServerError
 }
@@ -10,6 +10,12 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	categoryErrors "github.com/AleksK1NG/products-microservice/pkg/category_errors"
+	deadLetterErrors "github.com/AleksK1NG/products-microservice/pkg/deadletter_errors"
+	operationErrors "github.com/AleksK1NG/products-microservice/pkg/operation_errors"
+	productErrors "github.com/AleksK1NG/products-microservice/pkg/product_errors"
 )
 
 const (
@@ -25,6 +31,7 @@ const (
 	ErrInvalidEmail     = "Invalid email"
 	ErrInvalidPassword  = "Invalid password"
 	ErrInvalidField     = "Invalid field"
+	ErrConflict         = "Conflict"
 )
 
 var (
@@ -162,6 +169,38 @@ func ParseErrors(err error) RestErr {
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return NewRestError(http.StatusNotFound, ErrNotFound, nil)
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return NewRestError(http.StatusNotFound, ErrNotFound, nil)
+	case errors.Is(err, deadLetterErrors.ErrInvalidReplayFilter):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error())
+	case errors.Is(err, operationErrors.ErrOperationNotFound):
+		return NewRestError(http.StatusNotFound, ErrNotFound, nil)
+	case errors.Is(err, productErrors.ErrProductNotFound):
+		return NewRestError(http.StatusNotFound, ErrNotFound, err.Error())
+	case errors.Is(err, productErrors.ErrVersionConflict):
+		return NewRestError(http.StatusConflict, ErrConflict, err.Error())
+	case errors.Is(err, productErrors.ErrInvalidOrderBy):
+		return NewRestError(http.StatusBadRequest, ErrBadQueryParams, err.Error())
+	case errors.Is(err, productErrors.ErrInvalidFilter):
+		return NewRestError(http.StatusBadRequest, ErrBadQueryParams, err.Error())
+	case errors.Is(err, productErrors.ErrInvalidCursor):
+		return NewRestError(http.StatusBadRequest, ErrBadQueryParams, err.Error())
+	case errors.Is(err, productErrors.ErrInvalidFieldMask):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error())
+	case errors.Is(err, productErrors.ErrInvalidBulkSize):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error())
+	case errors.Is(err, productErrors.ErrProductAlreadyExists):
+		return NewRestError(http.StatusConflict, ErrAlreadyExists, err.Error())
+	case errors.Is(err, productErrors.ErrIdempotencyInProgress):
+		return NewRestError(http.StatusConflict, ErrConflict, err.Error())
+	case errors.Is(err, productErrors.ErrIdempotencyKeyReused):
+		return NewRestError(http.StatusUnprocessableEntity, ErrBadRequest, err.Error())
+	case errors.Is(err, categoryErrors.ErrCategoryNotFound):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error())
+	case errors.Is(err, categoryErrors.ErrInvalidParentCategory):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error())
+	case errors.Is(err, categoryErrors.ErrCategoryNotEmpty):
+		return NewRestError(http.StatusConflict, ErrConflict, err.Error())
 	case errors.Is(err, context.DeadlineExceeded):
 		return NewRestError(http.StatusRequestTimeout, ErrRequestTimeout, nil)
 	case errors.Is(err, Unauthorized):
@@ -0,0 +1,7 @@
+package operationErrors
+
+import "github.com/pkg/errors"
+
+var (
+	ErrOperationNotFound = errors.New("operation not found")
+)
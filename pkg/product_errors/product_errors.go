@@ -4,4 +4,16 @@ import "github.com/pkg/errors"
 
 var (
 	ErrObjectIDTypeConversion = errors.New("object id type conversion")
+	ErrInvalidOrderBy         = errors.New("invalid order by")
+	ErrInvalidFilter          = errors.New("invalid filter")
+	ErrInvalidCursor          = errors.New("invalid cursor")
+	ErrProductAlreadyExists   = errors.New("product already exists")
+	ErrProductNotFound        = errors.New("product not found")
+	ErrVersionConflict        = errors.New("product version conflict")
+	ErrInvalidFieldMask       = errors.New("invalid field mask")
+	ErrIdempotencyInProgress  = errors.New("request with idempotency key is in progress")
+	ErrIdempotencyKeyReused   = errors.New("idempotency key is used by different request")
+	ErrInvalidBulkSize        = errors.New("invalid bulk size")
+	ErrDuplicateBulkProduct   = errors.New("duplicate product in bulk request")
+	ErrMissingBulkProductID   = errors.New("missing product id in bulk request")
 )
@@ -4,20 +4,37 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 )
 
 const (
 	defaultSize = 10
+
+	orderByFieldsSeparator = ","
+	orderByDirSeparator    = ":"
+	orderByAsc             = "asc"
+	orderByDesc            = "desc"
 )
 
+// SortField single order by field
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
 // Pagination query params
 type Pagination struct {
-	Size    int    `json:"size,omitempty"`
-	Page    int    `json:"page,omitempty"`
-	OrderBy string `json:"orderBy,omitempty"`
+	Size      int    `json:"size,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	OrderBy   string `json:"orderBy,omitempty"`
+	Cursor    string `json:"cursor,omitempty"`
+	SkipCount bool   `json:"skipCount,omitempty"`
 }
 
 func NewPaginationQuery(size int, page int) *Pagination {
+	if size <= 0 {
+		size = defaultSize
+	}
 	return &Pagination{Size: size, Page: page}
 }
 
@@ -39,7 +56,7 @@ func (q *Pagination) SetSize(sizeQuery string) error {
 // SetPage Set page number
 func (q *Pagination) SetPage(pageQuery string) error {
 	if pageQuery == "" {
-		q.Size = 0
+		q.Page = 0
 		return nil
 	}
 	n, err := strconv.Atoi(pageQuery)
@@ -56,6 +73,26 @@ func (q *Pagination) SetOrderBy(orderByQuery string) {
 	q.OrderBy = orderByQuery
 }
 
+// SetCursor Set keyset cursor, page is ignored when cursor is set
+func (q *Pagination) SetCursor(cursorQuery string) {
+	q.Cursor = cursorQuery
+}
+
+// SetSkipCount Set skip count
+func (q *Pagination) SetSkipCount(skipCountQuery string) error {
+	if skipCountQuery == "" {
+		q.SkipCount = false
+		return nil
+	}
+	skipCount, err := strconv.ParseBool(skipCountQuery)
+	if err != nil {
+		return err
+	}
+	q.SkipCount = skipCount
+
+	return nil
+}
+
 // GetOffset Get offset
 func (q *Pagination) GetOffset() int {
 	if q.Page == 0 {
@@ -74,6 +111,41 @@ func (q *Pagination) GetOrderBy() string {
 	return q.OrderBy
 }
 
+// GetSortFields Parse OrderBy, comma separated fields with optional direction, e.g. "price:desc,name"
+func (q *Pagination) GetSortFields() ([]SortField, error) {
+	if strings.TrimSpace(q.OrderBy) == "" {
+		return nil, nil
+	}
+
+	fields := strings.Split(q.OrderBy, orderByFieldsSeparator)
+	sortFields := make([]SortField, 0, len(fields))
+	for _, field := range fields {
+		parts := strings.Split(strings.TrimSpace(field), orderByDirSeparator)
+		if len(parts) > 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid order by field: %q", field)
+		}
+
+		sortField := SortField{Field: parts[0]}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case orderByAsc:
+			case orderByDesc:
+				sortField.Desc = true
+			default:
+				return nil, fmt.Errorf("invalid order by direction: %q", parts[1])
+			}
+		}
+		sortFields = append(sortFields, sortField)
+	}
+
+	return sortFields, nil
+}
+
+// GetCursor Get keyset cursor
+func (q *Pagination) GetCursor() string {
+	return q.Cursor
+}
+
 // GetPage Get OrderBy
 func (q *Pagination) GetPage() int {
 	return q.Page
@@ -86,7 +158,7 @@ func (q *Pagination) GetSize() int {
 
 // GetQueryString get query string
 func (q *Pagination) GetQueryString() string {
-	return fmt.Sprintf("page=%v&size=%v&orderBy=%s", q.GetPage(), q.GetSize(), q.GetOrderBy())
+	return fmt.Sprintf("page=%v&size=%v&orderBy=%s&cursor=%s", q.GetPage(), q.GetSize(), q.GetOrderBy(), q.GetCursor())
 }
 
 // GetTotalPages Get total pages int
@@ -0,0 +1,364 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: deadletter.proto
+
+//protoc --go_out=plugins=grpc:. *.proto
+
+package deadLettersService
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ReplayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics        []string               `protobuf:"bytes,1,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	ErrorContains string                 `protobuf:"bytes,4,opt,name=ErrorContains,proto3" json:"ErrorContains,omitempty"`
+	Limit         int64                  `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+}
+
+func (x *ReplayReq) Reset() {
+	*x = ReplayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deadletter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayReq) ProtoMessage() {}
+
+func (x *ReplayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_deadletter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayReq.ProtoReflect.Descriptor instead.
+func (*ReplayReq) Descriptor() ([]byte, []int) {
+	return file_deadletter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReplayReq) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ReplayReq) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReplayReq) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ReplayReq) GetErrorContains() string {
+	if x != nil {
+		return x.ErrorContains
+	}
+	return ""
+}
+
+func (x *ReplayReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReplayReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ReplayRes replay runs in background, its report is result of operation
+type ReplayRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationID string `protobuf:"bytes,2,opt,name=OperationID,proto3" json:"OperationID,omitempty"`
+}
+
+func (x *ReplayRes) Reset() {
+	*x = ReplayRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deadletter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRes) ProtoMessage() {}
+
+func (x *ReplayRes) ProtoReflect() protoreflect.Message {
+	mi := &file_deadletter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRes.ProtoReflect.Descriptor instead.
+func (*ReplayRes) Descriptor() ([]byte, []int) {
+	return file_deadletter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplayRes) GetOperationID() string {
+	if x != nil {
+		return x.OperationID
+	}
+	return ""
+}
+
+var File_deadletter_proto protoreflect.FileDescriptor
+
+var file_deadletter_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x2e, 0x0a,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x33, 0x0a,
+	0x09, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x32, 0x5e, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x12, 0x1d, 0x2e, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73,
+	0x22, 0x00, 0x42, 0x16, 0x5a, 0x14, 0x2e, 0x3b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_deadletter_proto_rawDescOnce sync.Once
+	file_deadletter_proto_rawDescData = file_deadletter_proto_rawDesc
+)
+
+func file_deadletter_proto_rawDescGZIP() []byte {
+	file_deadletter_proto_rawDescOnce.Do(func() {
+		file_deadletter_proto_rawDescData = protoimpl.X.CompressGZIP(file_deadletter_proto_rawDescData)
+	})
+	return file_deadletter_proto_rawDescData
+}
+
+var file_deadletter_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_deadletter_proto_goTypes = []interface{}{
+	(*ReplayReq)(nil),             // 0: deadLettersService.ReplayReq
+	(*ReplayRes)(nil),             // 1: deadLettersService.ReplayRes
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_deadletter_proto_depIdxs = []int32{
+	2, // 0: deadLettersService.ReplayReq.From:type_name -> google.protobuf.Timestamp
+	2, // 1: deadLettersService.ReplayReq.To:type_name -> google.protobuf.Timestamp
+	0, // 2: deadLettersService.DeadLettersService.Replay:input_type -> deadLettersService.ReplayReq
+	1, // 3: deadLettersService.DeadLettersService.Replay:output_type -> deadLettersService.ReplayRes
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_deadletter_proto_init() }
+func file_deadletter_proto_init() {
+	if File_deadletter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_deadletter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deadletter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_deadletter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_deadletter_proto_goTypes,
+		DependencyIndexes: file_deadletter_proto_depIdxs,
+		MessageInfos:      file_deadletter_proto_msgTypes,
+	}.Build()
+	File_deadletter_proto = out.File
+	file_deadletter_proto_rawDesc = nil
+	file_deadletter_proto_goTypes = nil
+	file_deadletter_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DeadLettersServiceClient is the client API for DeadLettersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeadLettersServiceClient interface {
+	Replay(ctx context.Context, in *ReplayReq, opts ...grpc.CallOption) (*ReplayRes, error)
+}
+
+type deadLettersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeadLettersServiceClient(cc grpc.ClientConnInterface) DeadLettersServiceClient {
+	return &deadLettersServiceClient{cc}
+}
+
+func (c *deadLettersServiceClient) Replay(ctx context.Context, in *ReplayReq, opts ...grpc.CallOption) (*ReplayRes, error) {
+	out := new(ReplayRes)
+	err := c.cc.Invoke(ctx, "/deadLettersService.DeadLettersService/Replay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeadLettersServiceServer is the server API for DeadLettersService service.
+type DeadLettersServiceServer interface {
+	Replay(context.Context, *ReplayReq) (*ReplayRes, error)
+}
+
+// UnimplementedDeadLettersServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDeadLettersServiceServer struct {
+}
+
+func (*UnimplementedDeadLettersServiceServer) Replay(context.Context, *ReplayReq) (*ReplayRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
+
+func RegisterDeadLettersServiceServer(s *grpc.Server, srv DeadLettersServiceServer) {
+	s.RegisterService(&_DeadLettersService_serviceDesc, srv)
+}
+
+func _DeadLettersService_Replay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadLettersServiceServer).Replay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deadLettersService.DeadLettersService/Replay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadLettersServiceServer).Replay(ctx, req.(*ReplayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeadLettersService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "deadLettersService.DeadLettersService",
+	HandlerType: (*DeadLettersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Replay",
+			Handler:    _DeadLettersService_Replay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deadletter.proto",
+}
@@ -3,6 +3,26 @@ use products
 db.products.stats()
 
 db.products.createIndex({ name: 1, description: 1 });
-db.products.createIndex({ '$**': 'text' });
+db.products.dropIndex('$**_text');
+db.products.createIndex(
+  { name: 'text', description: 'text' },
+  { name: 'products_text', weights: { name: 10, description: 2 }, default_language: 'english' },
+);
+db.products.createIndex({ status: 1 });
+db.products.createIndex({ categoryId: 1, _id: -1 });
+db.products.createIndex({ price: 1, _id: 1 });
+db.products.createIndex({ rating: 1, _id: 1 });
+db.products.createIndex({ createdAt: 1, _id: 1 });
+db.products.createIndex({ updatedAt: 1, _id: 1 });
+db.products.createIndex({ name: 1, _id: 1 });
+db.products.createIndex({ categoryId: 1, price: 1 });
+db.products.createIndex({ nameLower: 1 });
+db.products.updateMany({ version: { $exists: false } }, { $set: { version: 1 } });
+db.products.find({ nameLower: { $exists: false } }).forEach((p) =>
+  db.products.updateOne({ _id: p._id }, { $set: { nameLower: p.name.toLowerCase() } }),
+);
+
+db.categories.createIndex({ parentId: 1, name: 1 });
+db.categories.createIndex({ path: 1 });
 
 db.products.getIndexes();
\ No newline at end of file